@@ -0,0 +1,320 @@
+package just
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+// ConfigSource is a named source of configuration values.
+type ConfigSource struct {
+	// Name is used to report the origin of values.
+	Name string
+	// Load returns values of this source as a json-like document.
+	Load func() (map[string]any, error)
+
+	// foldKeys matches keys of the source to existing keys case-insensitively.
+	foldKeys bool
+}
+
+// ConfigFromValue returns the source which contains all json fields of `v`.
+// Typically, it is a struct with default values.
+func ConfigFromValue[T any](name string, v T) ConfigSource {
+	return ConfigSource{
+		Name: name,
+		Load: func() (map[string]any, error) {
+			bb, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("marshal value: %w", err)
+			}
+
+			return configParseDoc(bb)
+		},
+	}
+}
+
+// ConfigFromFile returns the source which reads json or yaml file. The format
+// is chosen by the file extension like in ParseTypeF.
+func ConfigFromFile(filename string) ConfigSource {
+	return ConfigSource{
+		Name: filename,
+		Load: func() (map[string]any, error) {
+			bb, err := readFile(filename, false)
+			if err != nil {
+				return nil, err
+			}
+
+			switch fileFormat(filename) {
+			case formatJSON:
+			case formatYAML:
+				bb, err = yaml.YAMLToJSON(bb)
+				if err != nil {
+					return nil, fmt.Errorf("convert yaml to json: %w", err)
+				}
+			default:
+				return nil, fmt.Errorf("parse file %q: %w", filename, ErrUnsupportedFormat)
+			}
+
+			return configParseDoc(bb)
+		},
+	}
+}
+
+// ConfigFromEnv returns the source which contains environment variables with
+// the name prefix `prefix`. The rest of the name is split by `__` into the
+// path to the value. Keys are matched case-insensitively.
+// Example: prefix `APP_`, `APP_DB__MAX_CONNS=10` => {db:{max_conns:10}}.
+// Values are converted to the type of the value from the previous sources or
+// of the zero value of the loaded type. Json arrays and objects are also
+// accepted.
+func ConfigFromEnv(prefix string) ConfigSource {
+	return ConfigSource{
+		Name: "env",
+		Load: func() (map[string]any, error) {
+			res := make(map[string]any)
+			for _, kv := range os.Environ() {
+				name, val, _ := strings.Cut(kv, "=")
+				if !strings.HasPrefix(name, prefix) || name == prefix {
+					continue
+				}
+
+				path := strings.Split(strings.ToLower(strings.TrimPrefix(name, prefix)), "__")
+				node := res
+				for _, key := range path[:len(path)-1] {
+					next, ok := node[key].(map[string]any)
+					if !ok {
+						next = make(map[string]any)
+						node[key] = next
+					}
+
+					node = next
+				}
+
+				node[path[len(path)-1]] = envValue(val)
+			}
+
+			return res, nil
+		},
+		foldKeys: true,
+	}
+}
+
+// ConfigFromMap returns the source with explicit values. `m` can contain
+// nested `map[string]any`.
+func ConfigFromMap(name string, m map[string]any) ConfigSource {
+	return ConfigSource{
+		Name: name,
+		Load: func() (map[string]any, error) {
+			bb, err := json.Marshal(m)
+			if err != nil {
+				return nil, fmt.Errorf("marshal map: %w", err)
+			}
+
+			return configParseDoc(bb)
+		},
+	}
+}
+
+// ConfigLoad loads all sources in order and deep-merges them like
+// MapMergeDeep: values from the later sources override values from the
+// earlier ones. The merged document is decoded into T by json rules.
+// Returns the value and the origins - the map from the dotted path of each
+// final value to the name of the source which supplied it.
+func ConfigLoad[T any](sources ...ConfigSource) (*T, map[string]string, error) {
+	// The zero value of T describes the shape of the document. It helps to
+	// convert environment variables when previous sources have no value.
+	hints, err := ConfigFromValue("", *new(T)).Load()
+	if err != nil {
+		hints = make(map[string]any)
+	}
+
+	doc := make(map[string]any)
+	origins := make(map[string]string)
+	for _, src := range sources {
+		values, err := src.Load()
+		if err != nil {
+			return nil, nil, fmt.Errorf("load source %q: %w", src.Name, err)
+		}
+
+		if src.foldKeys {
+			values, err = configResolveEnv(doc, values, hints, "")
+			if err != nil {
+				return nil, nil, fmt.Errorf("merge source %q: %w", src.Name, err)
+			}
+		}
+
+		configTrackOrigins(doc, values, "", src.Name, origins)
+		doc = MapMergeDeep(doc, values)
+	}
+
+	bb, err := json.Marshal(doc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal config: %w", err)
+	}
+
+	res, err := JsonParseType[T](bb)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, origins, nil
+}
+
+// envValue is a raw value of environment variable, which should be converted
+// to the type of the existing value.
+type envValue string
+
+func configParseDoc(bb []byte) (map[string]any, error) {
+	var res map[string]any
+	if err := json.Unmarshal(bb, &res); err != nil {
+		return nil, fmt.Errorf("document should be an object: %w", err)
+	}
+
+	if res == nil {
+		res = make(map[string]any)
+	}
+
+	return res, nil
+}
+
+// configResolveEnv returns values of the env source `src` with keys matched
+// to keys of `dst` or `hints` and values converted to their types. `hints`
+// contains values of the same shape as `dst`: nested objects of `hints`
+// describe nested objects of `dst`, scalar values are used when `dst` has no
+// value for the key.
+func configResolveEnv(dst, src, hints map[string]any, prefix string) (map[string]any, error) {
+	res := make(map[string]any, len(src))
+	for key, val := range src {
+		key = configFoldKey(dst, hints, key)
+		path := configJoinPath(prefix, key)
+
+		switch v := val.(type) {
+		case map[string]any:
+			// The shape of nested objects always comes from T, because the
+			// current object can miss some fields.
+			dstMap, _ := dst[key].(map[string]any)
+			hintMap, _ := hints[key].(map[string]any)
+			resolved, err := configResolveEnv(dstMap, v, hintMap, path)
+			if err != nil {
+				return nil, err
+			}
+
+			val = resolved
+		case envValue:
+			hint := dst[key]
+			if hint == nil {
+				hint = hints[key]
+			}
+
+			converted, err := configConvertEnv(hint, string(v))
+			if err != nil {
+				return nil, &FieldError{Path: path, Err: err}
+			}
+
+			val = converted
+		}
+
+		res[key] = val
+	}
+
+	return res, nil
+}
+
+// configTrackOrigins sets the origin `source` for all values of `src`, which
+// replace values of `dst` by MapMergeDeep.
+func configTrackOrigins(dst, src map[string]any, prefix, source string, origins map[string]string) {
+	for key, val := range src {
+		path := configJoinPath(prefix, key)
+		dstMap, dstIsMap := dst[key].(map[string]any)
+		srcMap, srcIsMap := val.(map[string]any)
+		if dstIsMap && srcIsMap {
+			configTrackOrigins(dstMap, srcMap, path, source, origins)
+			continue
+		}
+
+		for p := range origins {
+			if p == path || strings.HasPrefix(p, path+".") {
+				delete(origins, p)
+			}
+		}
+
+		configSetOrigins(val, path, source, origins)
+	}
+}
+
+func configSetOrigins(val any, path, source string, origins map[string]string) {
+	m, ok := val.(map[string]any)
+	if !ok || len(m) == 0 {
+		origins[path] = source
+		return
+	}
+
+	for k, v := range m {
+		configSetOrigins(v, configJoinPath(path, k), source, origins)
+	}
+}
+
+func configFoldKey(m, hints map[string]any, key string) string {
+	for _, candidates := range []map[string]any{m, hints} {
+		if _, ok := candidates[key]; ok {
+			return key
+		}
+
+		for k := range candidates {
+			if strings.EqualFold(k, key) {
+				return k
+			}
+		}
+	}
+
+	return key
+}
+
+func configJoinPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+
+	return prefix + "." + key
+}
+
+func configConvertEnv(current any, raw string) (any, error) {
+	switch current.(type) {
+	case string:
+		return raw, nil
+	case nil:
+		// The type is unknown, so only json arrays and objects are decoded.
+		var v any
+		isDoc := strings.HasPrefix(raw, "[") || strings.HasPrefix(raw, "{")
+		if isDoc && json.Unmarshal([]byte(raw), &v) == nil {
+			return v, nil
+		}
+
+		return raw, nil
+	case float64:
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse number: %w", err)
+		}
+
+		return v, nil
+	case bool:
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("parse bool: %w", err)
+		}
+
+		return v, nil
+	default:
+		var v any
+		if err := json.Unmarshal([]byte(raw), &v); err != nil {
+			return nil, fmt.Errorf("parse json: %w", err)
+		}
+
+		return v, nil
+	}
+}
@@ -0,0 +1,152 @@
+package just_test
+
+import (
+	"testing"
+
+	"github.com/kazhuravlev/just"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testDBConfig struct {
+	Host     string   `json:"host"`
+	Port     int      `json:"port"`
+	MaxConns int      `json:"max_conns"`
+	Replicas []string `json:"replicas"`
+}
+
+type testConfig struct {
+	Name     string       `json:"name"`
+	Debug    bool         `json:"debug"`
+	LogLevel string       `json:"logLevel"`
+	DB       testDBConfig `json:"db"`
+}
+
+func TestConfigLoad(t *testing.T) {
+	defaults := testConfig{
+		Name:     "app",
+		LogLevel: "info",
+		DB:       testDBConfig{Host: "localhost", Port: 5432, MaxConns: 10},
+	}
+
+	t.Run("defaults_only", func(t *testing.T) {
+		res, origins, err := just.ConfigLoad[testConfig](just.ConfigFromValue("defaults", defaults))
+		require.NoError(t, err)
+		assert.Equal(t, defaults, *res)
+		assert.Equal(t, "defaults", origins["db.host"])
+		assert.Equal(t, "defaults", origins["db.replicas"])
+	})
+
+	t.Run("all_sources", func(t *testing.T) {
+		jsonFile := writeTempFile(t, "config.json", `{"name":"from-json","db":{"host":"db.local"}}`)
+		yamlFile := writeTempFile(t, "config.yaml", "db:\n  port: 6432\n  replicas: [r1, r2]\n")
+
+		t.Setenv("JUST_TEST_DB__MAX_CONNS", "50")
+		t.Setenv("JUST_TEST_DEBUG", "true")
+		t.Setenv("JUST_TEST_LOGLEVEL", "debug")
+
+		res, origins, err := just.ConfigLoad[testConfig](
+			just.ConfigFromValue("defaults", defaults),
+			just.ConfigFromFile(jsonFile),
+			just.ConfigFromFile(yamlFile),
+			just.ConfigFromEnv("JUST_TEST_"),
+			just.ConfigFromMap("flags", map[string]any{"db": map[string]any{"replicas": []string{"r3"}}}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, testConfig{
+			Name:     "from-json",
+			Debug:    true,
+			LogLevel: "debug",
+			DB: testDBConfig{
+				Host:     "db.local",
+				Port:     6432,
+				MaxConns: 50,
+				Replicas: []string{"r3"},
+			},
+		}, *res)
+		assert.Equal(t, map[string]string{
+			"name":         jsonFile,
+			"debug":        "env",
+			"logLevel":     "env",
+			"db.host":      jsonFile,
+			"db.port":      yamlFile,
+			"db.max_conns": "env",
+			"db.replicas":  "flags",
+		}, origins)
+	})
+
+	t.Run("env_without_defaults", func(t *testing.T) {
+		t.Setenv("JUST_TEST_DB__PORT", "15432")
+		t.Setenv("JUST_TEST_DB__REPLICAS", `["a","b"]`)
+		t.Setenv("JUST_TEST_NAME", "42")
+
+		res, origins, err := just.ConfigLoad[testConfig](just.ConfigFromEnv("JUST_TEST_"))
+		require.NoError(t, err)
+		assert.Equal(t, testConfig{
+			Name: "42",
+			DB:   testDBConfig{Port: 15432, Replicas: []string{"a", "b"}},
+		}, *res)
+		assert.Equal(t, "env", origins["db.port"])
+	})
+
+	t.Run("env_overrides_child_of_file_object", func(t *testing.T) {
+		filename := writeTempFile(t, "config.json", `{"db":{"host":"x"}}`)
+		t.Setenv("JUST_TEST_DB__PORT", "15432")
+
+		res, origins, err := just.ConfigLoad[testConfig](
+			just.ConfigFromFile(filename),
+			just.ConfigFromEnv("JUST_TEST_"),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, testDBConfig{Host: "x", Port: 15432}, res.DB)
+		assert.Equal(t, filename, origins["db.host"])
+		assert.Equal(t, "env", origins["db.port"])
+	})
+
+	t.Run("override_replaces_subtree_origins", func(t *testing.T) {
+		res, origins, err := just.ConfigLoad[testConfig](
+			just.ConfigFromValue("defaults", defaults),
+			just.ConfigFromMap("override", map[string]any{"db": nil}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, testDBConfig{}, res.DB)
+		assert.Equal(t, "override", origins["db"])
+		assert.NotContains(t, origins, "db.host")
+	})
+
+	t.Run("invalid_env_value", func(t *testing.T) {
+		t.Setenv("JUST_TEST_DB__PORT", "not-a-number")
+
+		_, _, err := just.ConfigLoad[testConfig](
+			just.ConfigFromValue("defaults", defaults),
+			just.ConfigFromEnv("JUST_TEST_"),
+		)
+		require.Error(t, err)
+
+		fieldErr, ok := just.ErrAs[*just.FieldError](err)
+		require.True(t, ok)
+		assert.Equal(t, "db.port", fieldErr.Path)
+	})
+
+	t.Run("invalid_file", func(t *testing.T) {
+		_, _, err := just.ConfigLoad[testConfig](just.ConfigFromFile("/path/not-exists/config.json"))
+		require.Error(t, err)
+
+		filename := writeTempFile(t, "config.toml", `name = "x"`)
+		_, _, err = just.ConfigLoad[testConfig](just.ConfigFromFile(filename))
+		require.ErrorIs(t, err, just.ErrUnsupportedFormat)
+
+		filename = writeTempFile(t, "config.json", `[1, 2]`)
+		_, _, err = just.ConfigLoad[testConfig](just.ConfigFromFile(filename))
+		require.Error(t, err)
+	})
+
+	t.Run("type_mismatch", func(t *testing.T) {
+		_, _, err := just.ConfigLoad[testConfig](just.ConfigFromMap("flags", map[string]any{"db": map[string]any{"port": "x"}}))
+		require.Error(t, err)
+
+		jsonErr, ok := just.ErrAs[*just.JsonError](err)
+		require.True(t, ok)
+		assert.Equal(t, "$.db.port", jsonErr.Path)
+	})
+}
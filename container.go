@@ -0,0 +1,331 @@
+package just
+
+// Deque is a double-ended queue based on a growable ring buffer. The zero
+// value is an empty deque ready to use. Deque is not thread-safe.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+// NewDeque returns an empty deque, which can contain `capacity` elements
+// without allocations.
+func NewDeque[T any](capacity int) *Deque[T] {
+	return &Deque[T]{buf: make([]T, Max(capacity, 0))}
+}
+
+// Len returns the number of elements in the deque.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// PushBack adds the element to the back of the deque.
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+	d.buf[d.idx(d.size)] = v
+	d.size++
+}
+
+// PushFront adds the element to the front of the deque.
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+	d.head = d.idx(len(d.buf) - 1)
+	d.buf[d.head] = v
+	d.size++
+}
+
+// PopBack removes and returns the element from the back of the deque.
+// Returns false when the deque is empty.
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	i := d.idx(d.size - 1)
+	v := d.buf[i]
+	d.buf[i] = zero
+	d.size--
+
+	return v, true
+}
+
+// PopFront removes and returns the element from the front of the deque.
+// Returns false when the deque is empty.
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	v := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.idx(1)
+	d.size--
+
+	return v, true
+}
+
+// Front returns the element from the front of the deque without removing
+// it. Returns false when the deque is empty.
+func (d *Deque[T]) Front() (T, bool) {
+	return d.Get(0)
+}
+
+// Back returns the element from the back of the deque without removing it.
+// Returns false when the deque is empty.
+func (d *Deque[T]) Back() (T, bool) {
+	return d.Get(d.size - 1)
+}
+
+// Get returns the element at `i`, where 0 is the front of the deque.
+// Returns false when `i` is out of range.
+func (d *Deque[T]) Get(i int) (T, bool) {
+	if i < 0 || i >= d.size {
+		var zero T
+		return zero, false
+	}
+
+	return d.buf[d.idx(i)], true
+}
+
+// Clear removes all elements from the deque and keeps allocated memory.
+func (d *Deque[T]) Clear() {
+	var zero T
+	for i := 0; i < d.size; i++ {
+		d.buf[d.idx(i)] = zero
+	}
+
+	d.head = 0
+	d.size = 0
+}
+
+// Iter create an iterator over elements from the front to the back of the
+// deque. Check this docs https://go.dev/ref/spec#For_range.
+func (d *Deque[T]) Iter() func(func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(i, d.buf[d.idx(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns elements from the front to the back of the deque.
+func (d *Deque[T]) ToSlice() []T {
+	res := make([]T, d.size)
+	n := copy(res, d.buf[d.head:Min(d.head+d.size, len(d.buf))])
+	copy(res[n:], d.buf[:d.size-n])
+
+	return res
+}
+
+// idx returns the index in the buffer of the element at `i`.
+func (d *Deque[T]) idx(i int) int {
+	i += d.head
+	if i >= len(d.buf) {
+		i -= len(d.buf)
+	}
+
+	return i
+}
+
+// grow doubles the buffer when it is full.
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+
+	buf := make([]T, Max(2*len(d.buf), 8))
+	copy(buf, d.ToSlice())
+	d.buf = buf
+	d.head = 0
+}
+
+// Ring is a fixed-capacity buffer, which overwrites the oldest element when
+// it is full. Ring is not thread-safe.
+type Ring[T any] struct {
+	d Deque[T]
+}
+
+// NewRing returns an empty ring buffer, which keeps not more than `capacity`
+// newest elements.
+func NewRing[T any](capacity int) *Ring[T] {
+	if capacity < 1 {
+		panic("capacity should be >= 1")
+	}
+
+	return &Ring[T]{d: Deque[T]{buf: make([]T, capacity)}}
+}
+
+// Len returns the number of elements in the ring.
+func (r *Ring[T]) Len() int {
+	return r.d.Len()
+}
+
+// Cap returns the max number of elements in the ring.
+func (r *Ring[T]) Cap() int {
+	return len(r.d.buf)
+}
+
+// Push adds the element to the ring. When the ring is full, the oldest
+// element is overwritten and returned with true.
+func (r *Ring[T]) Push(v T) (T, bool) {
+	var evicted T
+	var ok bool
+	if r.d.Len() == len(r.d.buf) {
+		evicted, ok = r.d.PopFront()
+	}
+
+	r.d.PushBack(v)
+
+	return evicted, ok
+}
+
+// Oldest returns the oldest element. Returns false when the ring is empty.
+func (r *Ring[T]) Oldest() (T, bool) {
+	return r.d.Front()
+}
+
+// Newest returns the newest element. Returns false when the ring is empty.
+func (r *Ring[T]) Newest() (T, bool) {
+	return r.d.Back()
+}
+
+// Get returns the element at `i`, where 0 is the oldest element. Returns
+// false when `i` is out of range.
+func (r *Ring[T]) Get(i int) (T, bool) {
+	return r.d.Get(i)
+}
+
+// Clear removes all elements from the ring.
+func (r *Ring[T]) Clear() {
+	r.d.Clear()
+}
+
+// Iter create an iterator over elements from the oldest to the newest.
+// Check this docs https://go.dev/ref/spec#For_range.
+func (r *Ring[T]) Iter() func(func(int, T) bool) {
+	return r.d.Iter()
+}
+
+// ToSlice returns elements from the oldest to the newest.
+func (r *Ring[T]) ToSlice() []T {
+	return r.d.ToSlice()
+}
+
+// Stack is a LIFO container. The zero value is an empty stack ready to use.
+// Stack is not thread-safe.
+type Stack[T any] struct {
+	items []T
+}
+
+// NewStack returns an empty stack, which can contain `capacity` elements
+// without allocations.
+func NewStack[T any](capacity int) *Stack[T] {
+	return &Stack[T]{items: make([]T, 0, Max(capacity, 0))}
+}
+
+// Len returns the number of elements in the stack.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// Push adds the element to the top of the stack.
+func (s *Stack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+// Pop removes and returns the element from the top of the stack. Returns
+// false when the stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+
+	n := len(s.items) - 1
+	v := s.items[n]
+	s.items[n] = zero
+	s.items = s.items[:n]
+
+	return v, true
+}
+
+// Peek returns the element from the top of the stack without removing it.
+// Returns false when the stack is empty.
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return s.items[len(s.items)-1], true
+}
+
+// Iter create an iterator over elements from the top to the bottom of the
+// stack, i.e. in order of popping. Check this docs
+// https://go.dev/ref/spec#For_range.
+func (s *Stack[T]) Iter() func(func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		for i := len(s.items) - 1; i >= 0; i-- {
+			if !yield(len(s.items)-1-i, s.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns elements from the top to the bottom of the stack, i.e. in
+// order of popping.
+func (s *Stack[T]) ToSlice() []T {
+	return SliceReverse(s.items)
+}
+
+// Queue is a FIFO container. The zero value is an empty queue ready to use.
+// Queue is not thread-safe.
+type Queue[T any] struct {
+	d Deque[T]
+}
+
+// NewQueue returns an empty queue, which can contain `capacity` elements
+// without allocations.
+func NewQueue[T any](capacity int) *Queue[T] {
+	return &Queue[T]{d: *NewDeque[T](capacity)}
+}
+
+// Len returns the number of elements in the queue.
+func (q *Queue[T]) Len() int {
+	return q.d.Len()
+}
+
+// Push adds the element to the back of the queue.
+func (q *Queue[T]) Push(v T) {
+	q.d.PushBack(v)
+}
+
+// Pop removes and returns the element from the front of the queue. Returns
+// false when the queue is empty.
+func (q *Queue[T]) Pop() (T, bool) {
+	return q.d.PopFront()
+}
+
+// Peek returns the element from the front of the queue without removing it.
+// Returns false when the queue is empty.
+func (q *Queue[T]) Peek() (T, bool) {
+	return q.d.Front()
+}
+
+// Iter create an iterator over elements from the front to the back of the
+// queue, i.e. in order of popping. Check this docs
+// https://go.dev/ref/spec#For_range.
+func (q *Queue[T]) Iter() func(func(int, T) bool) {
+	return q.d.Iter()
+}
+
+// ToSlice returns elements from the front to the back of the queue, i.e. in
+// order of popping.
+func (q *Queue[T]) ToSlice() []T {
+	return q.d.ToSlice()
+}
@@ -0,0 +1,112 @@
+package just_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kazhuravlev/just"
+)
+
+// BenchmarkQueue compares Queue with a slice used as a FIFO queue.
+func BenchmarkQueue(b *testing.B) {
+	sizes := []int{10, 1000}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("size_%d/queue", size), func(b *testing.B) {
+			var q just.Queue[int]
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < size; j++ {
+					q.Push(j)
+				}
+				for j := 0; j < size; j++ {
+					_, _ = q.Pop()
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("size_%d/slice", size), func(b *testing.B) {
+			var q []int
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < size; j++ {
+					q = append(q, j)
+				}
+				for j := 0; j < size; j++ {
+					_ = q[0]
+					q = q[1:]
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkRing compares Ring with SliceLastN over a growing slice for
+// keeping the most recent elements.
+func BenchmarkRing(b *testing.B) {
+	sizes := []int{10, 1000}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("size_%d/ring", size), func(b *testing.B) {
+			r := just.NewRing[int](size)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				r.Push(i)
+				if i%size == 0 {
+					_ = r.ToSlice()
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("size_%d/slice_last_n", size), func(b *testing.B) {
+			var in []int
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				in = append(in, i)
+				if i%size == 0 {
+					_ = just.SliceLastN(in, size)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkStack compares Stack with a plain slice.
+func BenchmarkStack(b *testing.B) {
+	sizes := []int{10, 1000}
+
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("size_%d/stack", size), func(b *testing.B) {
+			var s just.Stack[int]
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < size; j++ {
+					s.Push(j)
+				}
+				for j := 0; j < size; j++ {
+					_, _ = s.Pop()
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("size_%d/slice", size), func(b *testing.B) {
+			var s []int
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < size; j++ {
+					s = append(s, j)
+				}
+				for j := 0; j < size; j++ {
+					_ = s[len(s)-1]
+					s = s[:len(s)-1]
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package just_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/kazhuravlev/just"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func iterValues[T any](iter func(func(int, T) bool)) []T {
+	res := make([]T, 0)
+	iter(func(i int, v T) bool {
+		res = append(res, v)
+		return true
+	})
+
+	return res
+}
+
+func TestDeque(t *testing.T) {
+	t.Parallel()
+
+	var d just.Deque[int]
+	_, ok := d.PopFront()
+	assert.False(t, ok)
+	_, ok = d.PopBack()
+	assert.False(t, ok)
+	_, ok = d.Front()
+	assert.False(t, ok)
+	_, ok = d.Back()
+	assert.False(t, ok)
+	assert.Equal(t, []int{}, d.ToSlice())
+
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	d.PushFront(0)
+	assert.Equal(t, 4, d.Len())
+	assert.Equal(t, []int{0, 1, 2, 3}, d.ToSlice())
+	assert.Equal(t, []int{0, 1, 2, 3}, iterValues(d.Iter()))
+
+	v, ok := d.Front()
+	assert.True(t, ok)
+	assert.Equal(t, 0, v)
+
+	v, ok = d.Back()
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+
+	v, ok = d.Get(2)
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+
+	_, ok = d.Get(4)
+	assert.False(t, ok)
+	_, ok = d.Get(-1)
+	assert.False(t, ok)
+
+	v, _ = d.PopFront()
+	assert.Equal(t, 0, v)
+	v, _ = d.PopBack()
+	assert.Equal(t, 3, v)
+	assert.Equal(t, []int{1, 2}, d.ToSlice())
+
+	d.Clear()
+	assert.Equal(t, 0, d.Len())
+	assert.Equal(t, []int{}, d.ToSlice())
+
+	t.Run("iter_break", func(t *testing.T) {
+		d := just.NewDeque[int](2)
+		d.PushBack(1)
+		d.PushBack(2)
+		d.PushBack(3)
+
+		var res []int
+		d.Iter()(func(i int, v int) bool {
+			res = append(res, v)
+			return i < 1
+		})
+		assert.Equal(t, []int{1, 2}, res)
+	})
+
+	t.Run("random", func(t *testing.T) {
+		rnd := rand.New(rand.NewSource(1))
+		d := just.NewDeque[int](0)
+		exp := make([]int, 0)
+		for i := 0; i < 2000; i++ {
+			switch rnd.Intn(4) {
+			case 0:
+				d.PushBack(i)
+				exp = append(exp, i)
+			case 1:
+				d.PushFront(i)
+				exp = append([]int{i}, exp...)
+			case 2:
+				v, ok := d.PopBack()
+				require.Equal(t, len(exp) != 0, ok)
+				if ok {
+					require.Equal(t, exp[len(exp)-1], v)
+					exp = exp[:len(exp)-1]
+				}
+			case 3:
+				v, ok := d.PopFront()
+				require.Equal(t, len(exp) != 0, ok)
+				if ok {
+					require.Equal(t, exp[0], v)
+					exp = exp[1:]
+				}
+			}
+
+			require.Equal(t, len(exp), d.Len())
+		}
+		require.Equal(t, exp, d.ToSlice())
+	})
+}
+
+func TestRing(t *testing.T) {
+	t.Parallel()
+
+	r := just.NewRing[int](3)
+	assert.Equal(t, 3, r.Cap())
+	_, ok := r.Oldest()
+	assert.False(t, ok)
+	_, ok = r.Newest()
+	assert.False(t, ok)
+
+	for i := 1; i <= 3; i++ {
+		_, evicted := r.Push(i)
+		assert.False(t, evicted)
+	}
+
+	v, evicted := r.Push(4)
+	assert.True(t, evicted)
+	assert.Equal(t, 1, v)
+
+	v, evicted = r.Push(5)
+	assert.True(t, evicted)
+	assert.Equal(t, 2, v)
+
+	assert.Equal(t, 3, r.Len())
+	assert.Equal(t, 3, r.Cap())
+	assert.Equal(t, []int{3, 4, 5}, r.ToSlice())
+	assert.Equal(t, []int{3, 4, 5}, iterValues(r.Iter()))
+
+	v, _ = r.Oldest()
+	assert.Equal(t, 3, v)
+	v, _ = r.Newest()
+	assert.Equal(t, 5, v)
+	v, _ = r.Get(1)
+	assert.Equal(t, 4, v)
+
+	r.Clear()
+	assert.Equal(t, 0, r.Len())
+	r.Push(6)
+	assert.Equal(t, []int{6}, r.ToSlice())
+	assert.Equal(t, 3, r.Cap())
+
+	t.Run("same_as_last_n", func(t *testing.T) {
+		r := just.NewRing[int](5)
+		in := make([]int, 0)
+		for i := 0; i < 23; i++ {
+			r.Push(i)
+			in = append(in, i)
+			require.Equal(t, just.SliceLastN(in, 5), r.ToSlice())
+		}
+	})
+
+	t.Run("invalid_capacity", func(t *testing.T) {
+		assert.Panics(t, func() { just.NewRing[int](0) })
+	})
+}
+
+func TestStack(t *testing.T) {
+	t.Parallel()
+
+	var s just.Stack[int]
+	_, ok := s.Pop()
+	assert.False(t, ok)
+	_, ok = s.Peek()
+	assert.False(t, ok)
+
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	assert.Equal(t, 3, s.Len())
+	assert.Equal(t, []int{3, 2, 1}, s.ToSlice())
+	assert.Equal(t, []int{3, 2, 1}, iterValues(s.Iter()))
+
+	v, ok := s.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+
+	v, ok = s.Pop()
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	assert.Equal(t, 2, s.Len())
+
+	s2 := just.NewStack[int](10)
+	assert.Equal(t, 0, s2.Len())
+	assert.Equal(t, []int{}, s2.ToSlice())
+}
+
+func TestQueue(t *testing.T) {
+	t.Parallel()
+
+	var q just.Queue[int]
+	_, ok := q.Pop()
+	assert.False(t, ok)
+	_, ok = q.Peek()
+	assert.False(t, ok)
+
+	q.Push(1)
+	q.Push(2)
+	q.Push(3)
+	assert.Equal(t, 3, q.Len())
+	assert.Equal(t, []int{1, 2, 3}, q.ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, iterValues(q.Iter()))
+
+	v, ok := q.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	v, ok = q.Pop()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, []int{2, 3}, q.ToSlice())
+
+	q2 := just.NewQueue[int](10)
+	q2.Push(1)
+	assert.Equal(t, []int{1}, q2.ToSlice())
+}
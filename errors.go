@@ -1,6 +1,9 @@
 package just
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 // ErrIsAnyOf returns true when at least one expression
 // `errors.Is(err, errSlice[N])` return true.
@@ -35,3 +38,30 @@ func ErrAs[T any](err error) (T, bool) {
 
 	return target, false
 }
+
+// FieldError is an error related to the specific field of the value.
+type FieldError struct {
+	// Path is a path to the field. Example: `db.hosts[0].port`.
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// FieldErrors contains errors for several fields.
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i := range e {
+		msgs[i] = e[i].Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
@@ -1,6 +1,7 @@
 package just_test
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"strconv"
@@ -117,3 +118,20 @@ func TestAsTestErrAs(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, customErr{reason: 13}, e)
 }
+
+func TestFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	errInvalid := errors.New("invalid")
+	err := fmt.Errorf("validate: %w", just.FieldErrors{
+		{Path: "db.host", Err: errInvalid},
+		{Path: "db.ports[1]", Err: errors.New("out of range")},
+	})
+
+	assert.Equal(t, "validate: db.host: invalid; db.ports[1]: out of range", err.Error())
+
+	fieldErrs, ok := just.ErrAs[just.FieldErrors](err)
+	assert.True(t, ok)
+	assert.Len(t, fieldErrs, 2)
+	assert.ErrorIs(t, fieldErrs[0], errInvalid)
+}
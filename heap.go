@@ -0,0 +1,431 @@
+package just
+
+import (
+	"container/heap"
+	"sync"
+)
+
+// HeapItem is a handle of the element in the Heap. It can be used to update
+// or remove the element. Methods of HeapItem are not safe for concurrent use
+// with SyncHeap, use SyncHeap.Value and SyncHeap.Contains instead.
+type HeapItem[T any] struct {
+	val T
+	// idx is an index of the element in the heap or -1 when the element
+	// is not in the heap.
+	idx int
+}
+
+// Value returns the value of the element.
+func (i *HeapItem[T]) Value() T {
+	return i.val
+}
+
+// InHeap returns true when the element was not popped or removed from the
+// heap.
+func (i *HeapItem[T]) InHeap() bool {
+	return i.idx != -1
+}
+
+// heapItems implements heap.Interface.
+type heapItems[T any] struct {
+	items []*HeapItem[T]
+	less  func(a, b T) bool
+}
+
+func (h *heapItems[T]) Len() int { return len(h.items) }
+
+func (h *heapItems[T]) Less(i, j int) bool { return h.less(h.items[i].val, h.items[j].val) }
+
+func (h *heapItems[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].idx = i
+	h.items[j].idx = j
+}
+
+func (h *heapItems[T]) Push(x any) {
+	item := x.(*HeapItem[T])
+	item.idx = len(h.items)
+	h.items = append(h.items, item)
+}
+
+func (h *heapItems[T]) Pop() any {
+	n := len(h.items) - 1
+	item := h.items[n]
+	h.items[n] = nil
+	h.items = h.items[:n]
+	item.idx = -1
+
+	return item
+}
+
+// sliceHeap implements heap.Interface for the slice, where the least element
+// according to `less` is at the top.
+type sliceHeap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h *sliceHeap[T]) Len() int { return len(h.items) }
+
+func (h *sliceHeap[T]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+
+func (h *sliceHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *sliceHeap[T]) Push(x any) { h.items = append(h.items, x.(T)) }
+
+func (h *sliceHeap[T]) Pop() any {
+	var zero T
+	n := len(h.items) - 1
+	v := h.items[n]
+	h.items[n] = zero
+	h.items = h.items[:n]
+
+	return v
+}
+
+// Heap is a priority queue, where the least element according to `less` is
+// popped first. Heap is not thread-safe, see SyncHeap.
+type Heap[T any] struct {
+	h heapItems[T]
+}
+
+// NewHeap returns an empty heap ordered by `less`.
+func NewHeap[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{
+		h: heapItems[T]{
+			items: make([]*HeapItem[T], 0),
+			less:  less,
+		},
+	}
+}
+
+// NewHeapFromSlice returns the heap which contains all elements of `in`.
+// It works in linear time, which is faster than pushing elements one by one.
+func NewHeapFromSlice[T any](in []T, less func(a, b T) bool) *Heap[T] {
+	items := make([]*HeapItem[T], len(in))
+	for i := range in {
+		items[i] = &HeapItem[T]{val: in[i], idx: i}
+	}
+
+	h := &Heap[T]{
+		h: heapItems[T]{
+			items: items,
+			less:  less,
+		},
+	}
+	heap.Init(&h.h)
+
+	return h
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return h.h.Len()
+}
+
+// Push adds the element to the heap and returns its handle.
+func (h *Heap[T]) Push(v T) *HeapItem[T] {
+	item := &HeapItem[T]{val: v}
+	heap.Push(&h.h, item)
+
+	return item
+}
+
+// Pop removes and returns the least element. Returns false when the heap is
+// empty.
+func (h *Heap[T]) Pop() (T, bool) {
+	if h.h.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return heap.Pop(&h.h).(*HeapItem[T]).val, true
+}
+
+// Peek returns the least element without removing it. Returns false when the
+// heap is empty.
+func (h *Heap[T]) Peek() (T, bool) {
+	if h.h.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return h.h.items[0].val, true
+}
+
+// Update sets the new value of the element and restores the order of the
+// heap. Returns false when the element is not in this heap.
+func (h *Heap[T]) Update(item *HeapItem[T], v T) bool {
+	if !h.contains(item) {
+		return false
+	}
+
+	item.val = v
+	heap.Fix(&h.h, item.idx)
+
+	return true
+}
+
+// Remove removes the element from the heap. Returns false when the element
+// is not in this heap.
+func (h *Heap[T]) Remove(item *HeapItem[T]) bool {
+	if !h.contains(item) {
+		return false
+	}
+
+	heap.Remove(&h.h, item.idx)
+
+	return true
+}
+
+// Values returns all elements of the heap in unspecified order.
+func (h *Heap[T]) Values() []T {
+	res := make([]T, len(h.h.items))
+	for i := range h.h.items {
+		res[i] = h.h.items[i].val
+	}
+
+	return res
+}
+
+// Contains returns true when the element is in this heap.
+func (h *Heap[T]) Contains(item *HeapItem[T]) bool {
+	return h.contains(item)
+}
+
+func (h *Heap[T]) contains(item *HeapItem[T]) bool {
+	return item != nil && item.idx >= 0 && item.idx < len(h.h.items) && h.h.items[item.idx] == item
+}
+
+// SyncHeap is a thread-safe version of Heap. Handles returned by Push are
+// modified under the lock of the heap, so read them by SyncHeap.Value and
+// SyncHeap.Contains.
+type SyncHeap[T any] struct {
+	mu sync.Mutex
+	h  *Heap[T]
+}
+
+// NewSyncHeap returns an empty thread-safe heap ordered by `less`.
+func NewSyncHeap[T any](less func(a, b T) bool) *SyncHeap[T] {
+	return &SyncHeap[T]{h: NewHeap(less)}
+}
+
+// NewSyncHeapFromSlice returns the thread-safe heap which contains all
+// elements of `in`.
+func NewSyncHeapFromSlice[T any](in []T, less func(a, b T) bool) *SyncHeap[T] {
+	return &SyncHeap[T]{h: NewHeapFromSlice(in, less)}
+}
+
+// Len returns the number of elements in the heap.
+func (h *SyncHeap[T]) Len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.h.Len()
+}
+
+// Push adds the element to the heap and returns its handle.
+func (h *SyncHeap[T]) Push(v T) *HeapItem[T] {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.h.Push(v)
+}
+
+// Pop removes and returns the least element. Returns false when the heap is
+// empty.
+func (h *SyncHeap[T]) Pop() (T, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.h.Pop()
+}
+
+// Peek returns the least element without removing it. Returns false when the
+// heap is empty.
+func (h *SyncHeap[T]) Peek() (T, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.h.Peek()
+}
+
+// Update sets the new value of the element and restores the order of the
+// heap. Returns false when the element is not in this heap.
+func (h *SyncHeap[T]) Update(item *HeapItem[T], v T) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.h.Update(item, v)
+}
+
+// Remove removes the element from the heap. Returns false when the element
+// is not in this heap.
+func (h *SyncHeap[T]) Remove(item *HeapItem[T]) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.h.Remove(item)
+}
+
+// Values returns all elements of the heap in unspecified order.
+func (h *SyncHeap[T]) Values() []T {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.h.Values()
+}
+
+// Value returns the value of the element. It is a thread-safe version of
+// HeapItem.Value.
+func (h *SyncHeap[T]) Value(item *HeapItem[T]) T {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return item.Value()
+}
+
+// Contains returns true when the element is in this heap. It is a
+// thread-safe version of HeapItem.InHeap.
+func (h *SyncHeap[T]) Contains(item *HeapItem[T]) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.h.Contains(item)
+}
+
+// boundedEntry is an element of BoundedHeap, which is stored in both heaps.
+type boundedEntry[T any] struct {
+	val   T
+	best  *HeapItem[*boundedEntry[T]]
+	worst *HeapItem[*boundedEntry[T]]
+}
+
+// BoundedHeap is a priority queue which keeps not more than `capacity` least
+// elements according to `less`. When the heap is full, the greatest (worst)
+// element is evicted. BoundedHeap is not thread-safe.
+type BoundedHeap[T any] struct {
+	capacity int
+	less     func(a, b T) bool
+	best     *Heap[*boundedEntry[T]]
+	worst    *Heap[*boundedEntry[T]]
+}
+
+// NewBoundedHeap returns an empty heap which keeps not more than `capacity`
+// least elements according to `less`.
+func NewBoundedHeap[T any](capacity int, less func(a, b T) bool) *BoundedHeap[T] {
+	if capacity < 1 {
+		panic("capacity should be >= 1")
+	}
+
+	return &BoundedHeap[T]{
+		capacity: capacity,
+		less:     less,
+		best: NewHeap(func(a, b *boundedEntry[T]) bool {
+			return less(a.val, b.val)
+		}),
+		worst: NewHeap(func(a, b *boundedEntry[T]) bool {
+			return less(b.val, a.val)
+		}),
+	}
+}
+
+// Len returns the number of elements in the heap.
+func (h *BoundedHeap[T]) Len() int {
+	return h.best.Len()
+}
+
+// Cap returns the max number of elements in the heap.
+func (h *BoundedHeap[T]) Cap() int {
+	return h.capacity
+}
+
+// Push adds the element to the heap. When the heap is full, the worst
+// element is evicted and returned with true. It can be `v` itself when it
+// is not better than all elements of the heap.
+func (h *BoundedHeap[T]) Push(v T) (T, bool) {
+	if h.best.Len() == h.capacity {
+		worst, _ := h.worst.Peek()
+		if !h.less(v, worst.val) {
+			return v, true
+		}
+
+		h.remove(worst)
+		h.add(v)
+
+		return worst.val, true
+	}
+
+	h.add(v)
+
+	var zero T
+	return zero, false
+}
+
+// Pop removes and returns the least (best) element. Returns false when the
+// heap is empty.
+func (h *BoundedHeap[T]) Pop() (T, bool) {
+	e, ok := h.best.Peek()
+	if !ok {
+		var zero T
+		return zero, false
+	}
+
+	h.remove(e)
+
+	return e.val, true
+}
+
+// PopWorst removes and returns the greatest (worst) element. Returns false
+// when the heap is empty.
+func (h *BoundedHeap[T]) PopWorst() (T, bool) {
+	e, ok := h.worst.Peek()
+	if !ok {
+		var zero T
+		return zero, false
+	}
+
+	h.remove(e)
+
+	return e.val, true
+}
+
+// Peek returns the least (best) element without removing it. Returns false
+// when the heap is empty.
+func (h *BoundedHeap[T]) Peek() (T, bool) {
+	e, ok := h.best.Peek()
+	if !ok {
+		var zero T
+		return zero, false
+	}
+
+	return e.val, true
+}
+
+// PeekWorst returns the greatest (worst) element without removing it.
+// Returns false when the heap is empty.
+func (h *BoundedHeap[T]) PeekWorst() (T, bool) {
+	e, ok := h.worst.Peek()
+	if !ok {
+		var zero T
+		return zero, false
+	}
+
+	return e.val, true
+}
+
+// Values returns all elements of the heap in unspecified order.
+func (h *BoundedHeap[T]) Values() []T {
+	return SliceMap(h.best.Values(), func(e *boundedEntry[T]) T { return e.val })
+}
+
+func (h *BoundedHeap[T]) add(v T) {
+	e := &boundedEntry[T]{val: v}
+	e.best = h.best.Push(e)
+	e.worst = h.worst.Push(e)
+}
+
+func (h *BoundedHeap[T]) remove(e *boundedEntry[T]) {
+	h.best.Remove(e.best)
+	h.worst.Remove(e.worst)
+}
@@ -0,0 +1,237 @@
+package just_test
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/kazhuravlev/just"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func popAll[T any](pop func() (T, bool)) []T {
+	res := make([]T, 0)
+	for {
+		v, ok := pop()
+		if !ok {
+			return res
+		}
+
+		res = append(res, v)
+	}
+}
+
+func TestHeap(t *testing.T) {
+	t.Parallel()
+
+	h := just.NewHeap(less)
+	_, ok := h.Peek()
+	assert.False(t, ok)
+	_, ok = h.Pop()
+	assert.False(t, ok)
+
+	for _, v := range []int{5, 1, 4, 2, 3, 1} {
+		h.Push(v)
+	}
+	assert.Equal(t, 6, h.Len())
+	assert.True(t, just.SliceEqualUnordered([]int{5, 1, 4, 2, 3, 1}, h.Values()))
+
+	v, ok := h.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 6, h.Len())
+
+	assert.Equal(t, []int{1, 1, 2, 3, 4, 5}, popAll(h.Pop))
+	assert.Equal(t, 0, h.Len())
+
+	t.Run("max_heap", func(t *testing.T) {
+		h := just.NewHeapFromSlice([]int{5, 1, 4, 2, 3}, just.LessReverse(less))
+		assert.Equal(t, []int{5, 4, 3, 2, 1}, popAll(h.Pop))
+	})
+
+	t.Run("random", func(t *testing.T) {
+		rnd := rand.New(rand.NewSource(1))
+		in := make([]int, 500)
+		for i := range in {
+			in[i] = rnd.Intn(100)
+		}
+
+		h := just.NewHeapFromSlice(in, less)
+		assert.Equal(t, just.SliceSortCopy(in, less), popAll(h.Pop))
+	})
+}
+
+func TestHeapUpdate(t *testing.T) {
+	t.Parallel()
+
+	type task struct {
+		Name     string
+		Priority int
+	}
+
+	h := just.NewHeap(just.LessBy(func(t task) int { return t.Priority }))
+	a := h.Push(task{"a", 1})
+	b := h.Push(task{"b", 2})
+	c := h.Push(task{"c", 3})
+	assert.Equal(t, task{"b", 2}, b.Value())
+
+	assert.True(t, h.Update(c, task{"c", 0}))
+	assert.True(t, h.Update(a, task{"a", 5}))
+	assert.True(t, h.Remove(b))
+	assert.False(t, b.InHeap())
+	assert.False(t, h.Remove(b))
+	assert.False(t, h.Update(b, task{"b", 1}))
+	assert.False(t, h.Remove(nil))
+	assert.False(t, h.Contains(b))
+	assert.True(t, h.Contains(a))
+
+	other := just.NewHeap(just.LessBy(func(t task) int { return t.Priority }))
+	assert.False(t, other.Update(a, task{"a", 1}))
+	assert.False(t, other.Remove(a))
+	assert.False(t, other.Contains(a))
+
+	assert.Equal(t, []task{{"c", 0}, {"a", 5}}, popAll(h.Pop))
+	assert.False(t, a.InHeap())
+	assert.False(t, c.InHeap())
+}
+
+func TestSyncHeap(t *testing.T) {
+	t.Parallel()
+
+	h := just.NewSyncHeap(less)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				item := h.Push(i*100 + j)
+				if j%10 == 0 {
+					h.Update(item, -(i*100 + j))
+				}
+				if j%25 == 0 {
+					h.Remove(item)
+				}
+				h.Peek()
+				h.Values()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	require.Equal(t, 960, h.Len())
+	res := popAll(h.Pop)
+	assert.True(t, just.SliceIsSorted(res, less))
+
+	h2 := just.NewSyncHeapFromSlice([]int{3, 1, 2}, less)
+	assert.Equal(t, []int{1, 2, 3}, popAll(h2.Pop))
+}
+
+func TestSyncHeapHandles(t *testing.T) {
+	t.Parallel()
+
+	h := just.NewSyncHeap(less)
+	items := make([]*just.HeapItem[int], 100)
+	for i := range items {
+		items[i] = h.Push(i)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+
+		for i := range items {
+			h.Update(items[i], -i)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < 50; i++ {
+			h.Pop()
+			h.Push(1000 + i)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+
+		for i := range items {
+			if h.Contains(items[i]) {
+				h.Value(items[i])
+			}
+		}
+	}()
+	wg.Wait()
+
+	assert.Equal(t, 100, h.Len())
+	for i := range items {
+		v := h.Value(items[i])
+		assert.True(t, v == i || v == -i, v)
+	}
+}
+
+func TestBoundedHeap(t *testing.T) {
+	t.Parallel()
+
+	h := just.NewBoundedHeap(3, less)
+	assert.Equal(t, 3, h.Cap())
+
+	_, evicted := h.Push(5)
+	assert.False(t, evicted)
+	h.Push(1)
+	h.Push(4)
+	assert.Equal(t, 3, h.Len())
+
+	v, evicted := h.Push(2)
+	assert.True(t, evicted)
+	assert.Equal(t, 5, v)
+
+	v, evicted = h.Push(7)
+	assert.True(t, evicted)
+	assert.Equal(t, 7, v)
+
+	assert.True(t, just.SliceEqualUnordered([]int{1, 2, 4}, h.Values()))
+
+	best, ok := h.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 1, best)
+
+	worst, ok := h.PeekWorst()
+	assert.True(t, ok)
+	assert.Equal(t, 4, worst)
+
+	worst, ok = h.PopWorst()
+	assert.True(t, ok)
+	assert.Equal(t, 4, worst)
+
+	assert.Equal(t, []int{1, 2}, popAll(h.Pop))
+
+	_, ok = h.Peek()
+	assert.False(t, ok)
+	_, ok = h.PeekWorst()
+	assert.False(t, ok)
+	_, ok = h.PopWorst()
+	assert.False(t, ok)
+
+	t.Run("random", func(t *testing.T) {
+		rnd := rand.New(rand.NewSource(1))
+		in := make([]int, 500)
+		for i := range in {
+			in[i] = rnd.Intn(100)
+		}
+
+		h := just.NewBoundedHeap(10, less)
+		for _, v := range in {
+			h.Push(v)
+		}
+		assert.Equal(t, just.SliceBottomK(in, 10, less), popAll(h.Pop))
+	})
+
+	t.Run("invalid_capacity", func(t *testing.T) {
+		assert.Panics(t, func() { just.NewBoundedHeap(0, less) })
+	})
+}
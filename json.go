@@ -1,16 +1,86 @@
 package just
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"reflect"
+	"strconv"
+	"strings"
 )
 
+// ErrJsonTrailingData returned when the json document contains data after
+// the top-level value and JsonOpts.AllowTrailingData is not set.
+var ErrJsonTrailingData = errors.New("trailing data after top-level value")
+
+// JsonOpts contains options for JsonParseTypeOpts.
+type JsonOpts struct {
+	// DisallowUnknownFields returns an error when the object contains keys
+	// which do not match any exported field of the destination struct.
+	DisallowUnknownFields bool
+	// UseNumber decodes numbers into `any` as json.Number instead of float64.
+	UseNumber bool
+	// AllowTrailingData ignores everything after the top-level value. By
+	// default, the document should contain only whitespace after it.
+	AllowTrailingData bool
+}
+
+// JsonError describes the location of a problem in the json document.
+type JsonError struct {
+	// Line is a 1-based line number.
+	Line int
+	// Column is a 1-based byte offset in the line.
+	Column int
+	// Offset is a 0-based byte offset in the document.
+	Offset int64
+	// Path is a path to the bad value. Example: `$.db.hosts[0]`.
+	Path string
+	Err  error
+}
+
+func (e *JsonError) Error() string {
+	return fmt.Sprintf("line %d, column %d (%s): %s", e.Line, e.Column, e.Path, e.Err)
+}
+
+func (e *JsonError) Unwrap() error {
+	return e.Err
+}
+
 // JsonParseType parse byte slice to specific type.
 func JsonParseType[T any](bb []byte) (*T, error) {
+	return JsonParseTypeOpts[T](bb, JsonOpts{})
+}
+
+// JsonParseTypeOpts parse byte slice to specific type according to `opts`.
+// Decoding errors are returned as *JsonError.
+func JsonParseTypeOpts[T any](bb []byte, opts JsonOpts) (*T, error) {
+	dec := json.NewDecoder(bytes.NewReader(bb))
+	if opts.DisallowUnknownFields {
+		dec.DisallowUnknownFields()
+	}
+
+	if opts.UseNumber {
+		dec.UseNumber()
+	}
+
 	var target T
-	if err := json.Unmarshal(bb, &target); err != nil {
-		return nil, fmt.Errorf("unmarshal type: %w", err)
+	if err := dec.Decode(&target); err != nil {
+		var unknownIn reflect.Type
+		if opts.DisallowUnknownFields {
+			unknownIn = reflect.TypeOf(&target).Elem()
+		}
+
+		return nil, fmt.Errorf("unmarshal type: %w", newJsonError(bb, err, unknownIn))
+	}
+
+	if !opts.AllowTrailingData {
+		offset := skipJsonSpace(bb, dec.InputOffset(), "")
+		if _, err := dec.Token(); err != io.EOF {
+			return nil, fmt.Errorf("unmarshal type: %w", newJsonErrorAt(bb, offset, "$", ErrJsonTrailingData))
+		}
 	}
 
 	return &target, nil
@@ -25,3 +95,361 @@ func JsonParseTypeF[T any](filename string) (*T, error) {
 
 	return JsonParseType[T](bb)
 }
+
+// JsonParseTypeFOpts parse json file into specific T according to `opts`.
+func JsonParseTypeFOpts[T any](filename string, opts JsonOpts) (*T, error) {
+	bb, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	return JsonParseTypeOpts[T](bb, opts)
+}
+
+// newJsonError returns the error with the location of the problem. When
+// `unknownIn` is not nil, an error without location is considered as
+// an unknown field error of the document decoded into `unknownIn`.
+func newJsonError(bb []byte, err error, unknownIn reflect.Type) *JsonError {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		path, _ := jsonPathAt(bb, syntaxErr.Offset)
+		return newJsonErrorAt(bb, syntaxErr.Offset, path, err)
+	case errors.As(err, &typeErr):
+		path, start := jsonPathAt(bb, typeErr.Offset)
+		return newJsonErrorAt(bb, start, path, err)
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		path, _ := jsonPathAt(bb, int64(len(bb)))
+		return newJsonErrorAt(bb, int64(len(bb)), path, io.ErrUnexpectedEOF)
+	case unknownIn != nil:
+		// The decoder does not report the position of unknown field, so it
+		// is found by walking the document along with fields of the type.
+		if path, start, ok := jsonFindUnknownField(bb, unknownIn); ok {
+			return newJsonErrorAt(bb, start, path, err)
+		}
+	}
+
+	return newJsonErrorAt(bb, 0, "$", err)
+}
+
+func newJsonErrorAt(bb []byte, offset int64, path string, err error) *JsonError {
+	if offset > int64(len(bb)) {
+		offset = int64(len(bb))
+	}
+
+	line, lineStart := 1, 0
+	for i := 0; i < int(offset); i++ {
+		if bb[i] == '\n' {
+			line++
+			lineStart = i + 1
+		}
+	}
+
+	return &JsonError{
+		Line:   line,
+		Column: int(offset) - lineStart + 1,
+		Offset: offset,
+		Path:   path,
+		Err:    err,
+	}
+}
+
+// skipJsonSpace returns the offset of the first byte, which is not a
+// whitespace or one of `extra` chars.
+func skipJsonSpace(bb []byte, offset int64, extra string) int64 {
+	for offset < int64(len(bb)) {
+		switch c := bb[offset]; {
+		case c == ' ', c == '\t', c == '\r', c == '\n', strings.IndexByte(extra, c) != -1:
+			offset++
+		default:
+			return offset
+		}
+	}
+
+	return offset
+}
+
+// jsonPathAt returns the path and the start offset of the token which ends
+// at or after `offset`.
+func jsonPathAt(bb []byte, offset int64) (string, int64) {
+	path, start := "$", int64(0)
+	jsonWalkTokens(bb, func(p string, tokStart, tokEnd int64, _ bool, _ json.Token) bool {
+		path, start = p, tokStart
+		return tokEnd < offset
+	})
+
+	return path, start
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// jsonFindUnknownField returns the path and the start offset of the first
+// object key, which does not match any field of the struct it is decoded
+// into. `t` is the type of the whole document.
+func jsonFindUnknownField(bb []byte, t reflect.Type) (string, int64, bool) {
+	dec := json.NewDecoder(bytes.NewReader(bb))
+	dec.UseNumber()
+
+	return jsonFindUnknownFieldIn(bb, dec, t, "$")
+}
+
+func jsonFindUnknownFieldIn(bb []byte, dec *json.Decoder, t reflect.Type, path string) (string, int64, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	tok, err := dec.Token()
+	if err != nil {
+		return "", 0, false
+	}
+
+	delim, isDelim := tok.(json.Delim)
+	if !isDelim || delim != '{' && delim != '[' {
+		return "", 0, false
+	}
+
+	// Values with custom decoding accept anything.
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+		jsonSkipValue(dec)
+		return "", 0, false
+	}
+
+	if delim == '[' {
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			jsonSkipValue(dec)
+			return "", 0, false
+		}
+
+		for i := 0; dec.More(); i++ {
+			if p, start, ok := jsonFindUnknownFieldIn(bb, dec, t.Elem(), path+"["+strconv.Itoa(i)+"]"); ok {
+				return p, start, true
+			}
+		}
+
+		_, _ = dec.Token()
+
+		return "", 0, false
+	}
+
+	var fields map[string]reflect.Type
+	switch t.Kind() {
+	case reflect.Struct:
+		fields = jsonStructFields(t)
+	case reflect.Map:
+	default:
+		jsonSkipValue(dec)
+		return "", 0, false
+	}
+
+	for dec.More() {
+		start := skipJsonSpace(bb, dec.InputOffset(), ",")
+		tok, err := dec.Token()
+		if err != nil {
+			return "", 0, false
+		}
+
+		key, _ := tok.(string)
+		keyPath := path + jsonPathKey(key)
+
+		var elemType reflect.Type
+		if fields == nil {
+			elemType = t.Elem()
+		} else {
+			var ok bool
+			if elemType, ok = jsonStructField(fields, key); !ok {
+				return keyPath, start, true
+			}
+		}
+
+		if p, start, ok := jsonFindUnknownFieldIn(bb, dec, elemType, keyPath); ok {
+			return p, start, true
+		}
+	}
+
+	_, _ = dec.Token()
+
+	return "", 0, false
+}
+
+// jsonStructField returns the type of the field which matches `key` in the
+// same way as encoding/json does: exact match first, then case-insensitive.
+func jsonStructField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if t, ok := fields[key]; ok {
+		return t, true
+	}
+
+	for name, t := range fields {
+		if strings.EqualFold(name, key) {
+			return t, true
+		}
+	}
+
+	return nil, false
+}
+
+// jsonStructFields returns types of struct fields by their json names,
+// including fields of embedded structs.
+func jsonStructFields(t reflect.Type) map[string]reflect.Type {
+	res := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		ft := f.Type
+		if f.Anonymous && name == "" {
+			for ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+
+			if ft.Kind() == reflect.Struct {
+				for k, v := range jsonStructFields(ft) {
+					if _, ok := res[k]; !ok {
+						res[k] = v
+					}
+				}
+
+				continue
+			}
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		res[name] = ft
+	}
+
+	return res
+}
+
+// jsonSkipValue reads tokens until the end of the container, which opening
+// delimiter was already read.
+func jsonSkipValue(dec *json.Decoder) {
+	for depth := 1; depth > 0; {
+		tok, err := dec.Token()
+		if err != nil {
+			return
+		}
+
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+	}
+}
+
+type jsonFrame struct {
+	isObj     bool
+	expectKey bool
+	key       string
+	idx       int
+}
+
+// jsonWalkTokens calls `fn` for each token in `bb` until `fn` returns false
+// or the document is over or broken. `path` is a path to the value, which
+// contains the token.
+func jsonWalkTokens(bb []byte, fn func(path string, start, end int64, isKey bool, tok json.Token) bool) {
+	dec := json.NewDecoder(bytes.NewReader(bb))
+	dec.UseNumber()
+
+	var stack []*jsonFrame
+	buildPath := func() string {
+		var sb strings.Builder
+		sb.WriteString("$")
+		for _, f := range stack {
+			if f.isObj {
+				sb.WriteString(jsonPathKey(f.key))
+			} else {
+				sb.WriteString("[" + strconv.Itoa(f.idx) + "]")
+			}
+		}
+
+		return sb.String()
+	}
+	// nextValue moves the parent container to the next element.
+	nextValue := func() {
+		if len(stack) == 0 {
+			return
+		}
+
+		top := stack[len(stack)-1]
+		if top.isObj {
+			top.expectKey = true
+		} else {
+			top.idx++
+		}
+	}
+
+	for {
+		start := skipJsonSpace(bb, dec.InputOffset(), ",:")
+		tok, err := dec.Token()
+		if err != nil {
+			return
+		}
+
+		end := dec.InputOffset()
+
+		var top *jsonFrame
+		if len(stack) != 0 {
+			top = stack[len(stack)-1]
+		}
+
+		switch {
+		case tok == json.Delim('}') || tok == json.Delim(']'):
+			stack = stack[:len(stack)-1]
+			path := buildPath()
+			if !fn(path, start, end, false, tok) {
+				return
+			}
+
+			nextValue()
+		case top != nil && top.isObj && top.expectKey:
+			top.key, _ = tok.(string)
+			top.expectKey = false
+			if !fn(buildPath(), start, end, true, tok) {
+				return
+			}
+		case tok == json.Delim('{') || tok == json.Delim('['):
+			if !fn(buildPath(), start, end, false, tok) {
+				return
+			}
+
+			stack = append(stack, &jsonFrame{
+				isObj:     tok == json.Delim('{'),
+				expectKey: tok == json.Delim('{'),
+			})
+		default:
+			if !fn(buildPath(), start, end, false, tok) {
+				return
+			}
+
+			nextValue()
+		}
+	}
+}
+
+func jsonPathKey(key string) string {
+	if key == "" {
+		return `[""]`
+	}
+
+	for _, c := range key {
+		isIdent := c == '_' || c == '-' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+		if !isIdent {
+			return "[" + strconv.Quote(key) + "]"
+		}
+	}
+
+	return "." + key
+}
@@ -1,10 +1,14 @@
 package just_test
 
 import (
-	"github.com/kazhuravlev/just"
-	"github.com/stretchr/testify/require"
+	"encoding/json"
+	"io"
 	"os"
 	"testing"
+
+	"github.com/kazhuravlev/just"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type SomeType struct {
@@ -47,3 +51,216 @@ func TestJsonParseTypeF(t *testing.T) {
 		require.Nil(t, res)
 	})
 }
+
+func TestJsonParseTypeOpts(t *testing.T) {
+	t.Parallel()
+
+	type server struct {
+		Host  string `json:"host"`
+		Ports []int  `json:"ports"`
+	}
+
+	type config struct {
+		Name    string   `json:"name"`
+		Servers []server `json:"servers"`
+		Extra   any      `json:"extra"`
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		res, err := just.JsonParseTypeOpts[config]([]byte(`{"name":"app","servers":[{"host":"a","ports":[1]}]}`), just.JsonOpts{})
+		require.NoError(t, err)
+		require.Equal(t, config{Name: "app", Servers: []server{{Host: "a", Ports: []int{1}}}}, *res)
+	})
+
+	t.Run("unknown_fields_allowed_by_default", func(t *testing.T) {
+		res, err := just.JsonParseTypeOpts[config]([]byte(`{"name":"app","unknown":1}`), just.JsonOpts{})
+		require.NoError(t, err)
+		require.Equal(t, "app", res.Name)
+	})
+
+	t.Run("disallow_unknown_fields", func(t *testing.T) {
+		in := "{\n  \"name\": \"app\",\n  \"servers\": [\n    {\"host\": \"a\", \"port\": 1}\n  ]\n}"
+		res, err := just.JsonParseTypeOpts[config]([]byte(in), just.JsonOpts{DisallowUnknownFields: true})
+		require.Error(t, err)
+		require.Nil(t, res)
+
+		jsonErr, ok := just.ErrAs[*just.JsonError](err)
+		require.True(t, ok)
+		assert.Equal(t, "$.servers[0].port", jsonErr.Path)
+		assert.Equal(t, 4, jsonErr.Line)
+		assert.Equal(t, 19, jsonErr.Column)
+
+		in = `{"extra":{"port":1},"servers":[{"host":"a","port":1}]}`
+		_, err = just.JsonParseTypeOpts[config]([]byte(in), just.JsonOpts{DisallowUnknownFields: true})
+		jsonErr, ok = just.ErrAs[*just.JsonError](err)
+		require.True(t, ok)
+		assert.Equal(t, "$.servers[0].port", jsonErr.Path)
+		assert.Equal(t, 1, jsonErr.Line)
+		assert.Equal(t, 44, jsonErr.Column)
+	})
+
+	t.Run("unknown_field_path_follows_type", func(t *testing.T) {
+		type base struct {
+			ID int `json:"id"`
+		}
+
+		type server struct {
+			base
+			Host string `json:"host"`
+			Port int
+		}
+
+		type doc struct {
+			Extra   map[string]any    `json:"extra"`
+			Servers []server          `json:"servers"`
+			ByName  map[string]server `json:"by_name"`
+			Raw     json.RawMessage   `json:"raw"`
+		}
+
+		table := []struct {
+			name   string
+			in     string
+			exp    string
+			column int
+		}{
+			{
+				name:   "same_key_in_untyped_value",
+				in:     `{"extra":{"port":1},"servers":[{"host":"a","prt":1}]}`,
+				exp:    "$.servers[0].prt",
+				column: 44,
+			},
+			{
+				name:   "map_of_structs",
+				in:     `{"by_name":{"a":{"host":"a"},"b":{"id":1,"user":"x"}}}`,
+				exp:    "$.by_name.b.user",
+				column: 42,
+			},
+			{
+				name:   "raw_message_accepts_anything",
+				in:     `{"raw":{"user":1},"servers":[{"PORT":1,"ID":2},{"user":"x"}]}`,
+				exp:    "$.servers[1].user",
+				column: 49,
+			},
+		}
+
+		for _, row := range table {
+			row := row
+			t.Run(row.name, func(t *testing.T) {
+				_, err := just.JsonParseTypeOpts[doc]([]byte(row.in), just.JsonOpts{DisallowUnknownFields: true})
+				require.Error(t, err)
+
+				jsonErr, ok := just.ErrAs[*just.JsonError](err)
+				require.True(t, ok)
+				assert.Equal(t, row.exp, jsonErr.Path)
+				assert.Equal(t, 1, jsonErr.Line)
+				assert.Equal(t, row.column, jsonErr.Column)
+			})
+		}
+	})
+
+	t.Run("use_number", func(t *testing.T) {
+		res, err := just.JsonParseTypeOpts[config]([]byte(`{"extra":12345678901234567890}`), just.JsonOpts{UseNumber: true})
+		require.NoError(t, err)
+		require.Equal(t, json.Number("12345678901234567890"), res.Extra)
+
+		res, err = just.JsonParseTypeOpts[config]([]byte(`{"extra":1}`), just.JsonOpts{})
+		require.NoError(t, err)
+		require.Equal(t, float64(1), res.Extra)
+	})
+
+	t.Run("trailing_data", func(t *testing.T) {
+		in := []byte("{\"name\":\"app\"}\n  {}")
+		res, err := just.JsonParseTypeOpts[config](in, just.JsonOpts{})
+		require.ErrorIs(t, err, just.ErrJsonTrailingData)
+		require.Nil(t, res)
+
+		jsonErr, ok := just.ErrAs[*just.JsonError](err)
+		require.True(t, ok)
+		assert.Equal(t, 2, jsonErr.Line)
+		assert.Equal(t, 3, jsonErr.Column)
+
+		res, err = just.JsonParseTypeOpts[config](in, just.JsonOpts{AllowTrailingData: true})
+		require.NoError(t, err)
+		require.Equal(t, "app", res.Name)
+
+		res, err = just.JsonParseTypeOpts[config]([]byte("{\"name\":\"app\"}\n\n"), just.JsonOpts{})
+		require.NoError(t, err)
+		require.Equal(t, "app", res.Name)
+	})
+
+	t.Run("type_error", func(t *testing.T) {
+		in := "{\n  \"servers\": [\n    {\"host\": \"a\"},\n    {\"host\": \"b\", \"ports\": [1, \"2\"]}\n  ]\n}"
+		res, err := just.JsonParseTypeOpts[config]([]byte(in), just.JsonOpts{})
+		require.Error(t, err)
+		require.Nil(t, res)
+
+		jsonErr, ok := just.ErrAs[*just.JsonError](err)
+		require.True(t, ok)
+		assert.Equal(t, "$.servers[1].ports[1]", jsonErr.Path)
+		assert.Equal(t, 4, jsonErr.Line)
+		assert.Equal(t, 32, jsonErr.Column)
+		assert.Contains(t, err.Error(), "line 4, column 32 ($.servers[1].ports[1])")
+
+		_, ok = just.ErrAs[*json.UnmarshalTypeError](err)
+		assert.True(t, ok)
+	})
+
+	t.Run("syntax_error", func(t *testing.T) {
+		in := "{\n  \"name\": \"app\",\n  \"servers\": [}\n}"
+		res, err := just.JsonParseTypeOpts[config]([]byte(in), just.JsonOpts{})
+		require.Error(t, err)
+		require.Nil(t, res)
+
+		jsonErr, ok := just.ErrAs[*just.JsonError](err)
+		require.True(t, ok)
+		assert.Equal(t, 3, jsonErr.Line)
+		assert.Equal(t, "$.servers", jsonErr.Path)
+
+		_, ok = just.ErrAs[*json.SyntaxError](err)
+		assert.True(t, ok)
+	})
+
+	t.Run("unexpected_eof", func(t *testing.T) {
+		res, err := just.JsonParseTypeOpts[config]([]byte(`{"name":`), just.JsonOpts{})
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		require.Nil(t, res)
+
+		res, err = just.JsonParseTypeOpts[config](nil, just.JsonOpts{})
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		require.Nil(t, res)
+	})
+
+	t.Run("special_keys", func(t *testing.T) {
+		res, err := just.JsonParseTypeOpts[map[string]map[string]int]([]byte(`{"a.b":{"":"x"}}`), just.JsonOpts{})
+		require.Error(t, err)
+		require.Nil(t, res)
+
+		jsonErr, ok := just.ErrAs[*just.JsonError](err)
+		require.True(t, ok)
+		assert.Equal(t, `$["a.b"][""]`, jsonErr.Path)
+	})
+}
+
+func TestJsonParseTypeFOpts(t *testing.T) {
+	t.Run("valid_type", func(t *testing.T) {
+		filename := writeTempFile(t, "file.json", `{"id":42}`)
+
+		res, err := just.JsonParseTypeFOpts[SomeType](filename, just.JsonOpts{DisallowUnknownFields: true})
+		require.NoError(t, err)
+		require.Equal(t, SomeType{ID: 42}, *res)
+	})
+
+	t.Run("unknown_field", func(t *testing.T) {
+		filename := writeTempFile(t, "file.json", `{"id":42,"name":"x"}`)
+
+		res, err := just.JsonParseTypeFOpts[SomeType](filename, just.JsonOpts{DisallowUnknownFields: true})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("file_not_exists", func(t *testing.T) {
+		res, err := just.JsonParseTypeFOpts[SomeType]("/path/not-exists/png", just.JsonOpts{})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
@@ -0,0 +1,122 @@
+package just
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Less returns true when `a` is less than `b`. It is the less function for
+// ordered types, which can be used with SliceSort and other functions.
+func Less[T constraints.Ordered](a, b T) bool {
+	return a < b
+}
+
+// LessBy returns the less function which compares elements by `key`.
+// Example: LessBy(func(u User) int { return u.Age })
+func LessBy[T any, K constraints.Ordered](key func(T) K) func(a, b T) bool {
+	return func(a, b T) bool {
+		return key(a) < key(b)
+	}
+}
+
+// LessByFn returns the less function which compares keys of elements by
+// `less`. Useful for keys which are not ordered, like pointers or NullVal.
+func LessByFn[T, K any](key func(T) K, less func(a, b K) bool) func(a, b T) bool {
+	return func(a, b T) bool {
+		return less(key(a), key(b))
+	}
+}
+
+// LessReverse returns the less function which reverses the order of `less`.
+func LessReverse[T any](less func(a, b T) bool) func(a, b T) bool {
+	return func(a, b T) bool {
+		return less(b, a)
+	}
+}
+
+// LessThen returns the less function which compares elements by the first
+// less function, then elements which are equal according to it by the
+// second one, and so on.
+// Example: LessThen(LessBy(byLastName), LessBy(byFirstName))
+func LessThen[T any](less ...func(a, b T) bool) func(a, b T) bool {
+	return func(a, b T) bool {
+		for _, fn := range less {
+			if fn(a, b) {
+				return true
+			}
+
+			if fn(b, a) {
+				return false
+			}
+		}
+
+		return false
+	}
+}
+
+// LessNullsFirst returns the less function for NullVal, which puts invalid
+// values before valid ones. Valid values are compared by `less`.
+func LessNullsFirst[T any](less func(a, b T) bool) func(a, b NullVal[T]) bool {
+	return func(a, b NullVal[T]) bool {
+		if !a.Valid || !b.Valid {
+			return !a.Valid && b.Valid
+		}
+
+		return less(a.Val, b.Val)
+	}
+}
+
+// LessNullsLast returns the less function for NullVal, which puts invalid
+// values after valid ones. Valid values are compared by `less`.
+func LessNullsLast[T any](less func(a, b T) bool) func(a, b NullVal[T]) bool {
+	return func(a, b NullVal[T]) bool {
+		if !a.Valid || !b.Valid {
+			return a.Valid && !b.Valid
+		}
+
+		return less(a.Val, b.Val)
+	}
+}
+
+// LessNilsFirst returns the less function for pointers, which puts nil
+// pointers before non-nil ones. Values of non-nil pointers are compared by
+// `less`.
+func LessNilsFirst[T any](less func(a, b T) bool) func(a, b *T) bool {
+	return func(a, b *T) bool {
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+
+		return less(*a, *b)
+	}
+}
+
+// LessNilsLast returns the less function for pointers, which puts nil
+// pointers after non-nil ones. Values of non-nil pointers are compared by
+// `less`.
+func LessNilsLast[T any](less func(a, b T) bool) func(a, b *T) bool {
+	return func(a, b *T) bool {
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+
+		return less(*a, *b)
+	}
+}
+
+// LessFold compares strings case-insensitively, by their lower case form.
+func LessFold[T ~string](a, b T) bool {
+	for a != "" && b != "" {
+		ra, sizeA := utf8.DecodeRuneInString(string(a))
+		rb, sizeB := utf8.DecodeRuneInString(string(b))
+		if la, lb := unicode.ToLower(ra), unicode.ToLower(rb); la != lb {
+			return la < lb
+		}
+
+		a, b = a[sizeA:], b[sizeB:]
+	}
+
+	return a == "" && b != ""
+}
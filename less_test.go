@@ -0,0 +1,129 @@
+package just_test
+
+import (
+	"testing"
+
+	"github.com/kazhuravlev/just"
+	"github.com/stretchr/testify/assert"
+)
+
+type lessUser struct {
+	Name  string
+	Age   int
+	Score just.NullVal[int]
+	Team  *string
+}
+
+func TestLessBy(t *testing.T) {
+	t.Parallel()
+
+	in := []lessUser{{Name: "b", Age: 2}, {Name: "a", Age: 3}, {Name: "c", Age: 1}}
+
+	res := just.SliceSortCopy(in, just.LessBy(func(u lessUser) int { return u.Age }))
+	assert.Equal(t, []string{"c", "b", "a"}, just.SliceMap(res, func(u lessUser) string { return u.Name }))
+
+	res = just.SliceSortCopy(in, just.LessReverse(just.LessBy(func(u lessUser) string { return u.Name })))
+	assert.Equal(t, []string{"c", "b", "a"}, just.SliceMap(res, func(u lessUser) string { return u.Name }))
+
+	assert.True(t, just.Less(1, 2))
+	assert.False(t, just.Less(2, 2))
+}
+
+func TestLessThen(t *testing.T) {
+	t.Parallel()
+
+	in := []lessUser{
+		{Name: "b", Age: 2},
+		{Name: "a", Age: 2},
+		{Name: "c", Age: 1},
+		{Name: "a", Age: 1},
+	}
+
+	byAgeDescThenName := just.LessThen(
+		just.LessReverse(just.LessBy(func(u lessUser) int { return u.Age })),
+		just.LessBy(func(u lessUser) string { return u.Name }),
+	)
+	just.SliceSort(in, byAgeDescThenName)
+	assert.Equal(t, []lessUser{
+		{Name: "a", Age: 2},
+		{Name: "b", Age: 2},
+		{Name: "a", Age: 1},
+		{Name: "c", Age: 1},
+	}, in)
+
+	assert.False(t, just.LessThen[int]()(1, 2))
+}
+
+func TestLessNulls(t *testing.T) {
+	t.Parallel()
+
+	in := []just.NullVal[int]{just.Null(2), just.NullNull[int](), just.Null(1)}
+
+	res := just.SliceSortCopy(in, just.LessNullsFirst(just.Less[int]))
+	assert.Equal(t, []just.NullVal[int]{just.NullNull[int](), just.Null(1), just.Null(2)}, res)
+
+	res = just.SliceSortCopy(in, just.LessNullsLast(just.Less[int]))
+	assert.Equal(t, []just.NullVal[int]{just.Null(1), just.Null(2), just.NullNull[int]()}, res)
+
+	t.Run("field", func(t *testing.T) {
+		in := []lessUser{
+			{Name: "a", Score: just.NullNull[int]()},
+			{Name: "b", Score: just.Null(10)},
+			{Name: "c", Score: just.Null(20)},
+		}
+
+		just.SliceSort(in, just.LessByFn(
+			func(u lessUser) just.NullVal[int] { return u.Score },
+			just.LessNullsLast(just.LessReverse(just.Less[int])),
+		))
+		assert.Equal(t, []string{"c", "b", "a"}, just.SliceMap(in, func(u lessUser) string { return u.Name }))
+	})
+}
+
+func TestLessNils(t *testing.T) {
+	t.Parallel()
+
+	in := []lessUser{
+		{Name: "a", Team: just.Pointer("y")},
+		{Name: "b"},
+		{Name: "c", Team: just.Pointer("x")},
+	}
+	team := func(u lessUser) *string { return u.Team }
+	names := func(in []lessUser) []string {
+		return just.SliceMap(in, func(u lessUser) string { return u.Name })
+	}
+
+	res := just.SliceSortCopy(in, just.LessByFn(team, just.LessNilsFirst(just.Less[string])))
+	assert.Equal(t, []string{"b", "c", "a"}, names(res))
+
+	res = just.SliceSortCopy(in, just.LessByFn(team, just.LessNilsLast(just.Less[string])))
+	assert.Equal(t, []string{"c", "a", "b"}, names(res))
+}
+
+func TestLessFold(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		a, b string
+		exp  bool
+	}{
+		{a: "a", b: "B", exp: true},
+		{a: "B", b: "a", exp: false},
+		{a: "A", b: "a", exp: false},
+		{a: "a", b: "A", exp: false},
+		{a: "ab", b: "AB", exp: false},
+		{a: "ab", b: "ABC", exp: true},
+		{a: "", b: "a", exp: true},
+		{a: "a", b: "", exp: false},
+		{a: "ÄPFEL", b: "äpfel", exp: false},
+		{a: "éa", b: "Éb", exp: true},
+	}
+
+	for _, row := range table {
+		assert.Equal(t, row.exp, just.LessFold(row.a, row.b), "%q < %q", row.a, row.b)
+	}
+
+	in := []string{"banana", "Apple", "cherry", "apple"}
+	just.SliceSort(in, just.LessFold[string])
+	assert.Equal(t, []string{"Apple", "apple", "banana", "cherry"}, in)
+}
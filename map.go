@@ -1,6 +1,10 @@
 package just
 
-import "golang.org/x/exp/maps"
+import (
+	"sort"
+
+	"golang.org/x/exp/maps"
+)
 
 // MapMerge returns the map which contains all keys from m1, m2, and values
 // from `fn(key, m1Value, m2Value)`.
@@ -209,6 +213,14 @@ func MapGetDefault[M ~map[K]V, K comparable, V any](in M, key K, defaultVal V) V
 	return v
 }
 
+// MapGetNull returns a valid NullVal with the value for a given key or an
+// invalid NullVal if the key is not present in the source map.
+func MapGetNull[M ~map[K]V, K comparable, V any](in M, key K) NullVal[V] {
+	v, ok := in[key]
+
+	return NullFromOk(v, ok)
+}
+
 // MapNotNil returns the source map when it is not nil or creates an empty
 // instance of this type.
 func MapNotNil[T ~map[K]V, K comparable, V any](in T) T {
@@ -247,3 +259,253 @@ func MapSetVal[M ~map[K]V, K comparable, V any](in M, key K, val V) M {
 	in[key] = val
 	return in
 }
+
+// MapMergeDeep returns the map which contains all keys from m1 and m2. When
+// the key exists in both maps the value from m2 wins, except the case when
+// both values are `map[string]any` - such values are merged recursively.
+// Useful for documents produced by json or yaml decoding.
+// Example: {a:{b:1, c:2}}, {a:{c:3}} => {a:{b:1, c:3}}
+func MapMergeDeep(m1, m2 map[string]any) map[string]any {
+	return MapMerge(m1, m2, func(k string, v1, v2 any) any {
+		if _, ok := m2[k]; !ok {
+			return v1
+		}
+
+		n1, ok1 := v1.(map[string]any)
+		n2, ok2 := v2.(map[string]any)
+		if ok1 && ok2 {
+			return MapMergeDeep(n1, n2)
+		}
+
+		return v2
+	})
+}
+
+// MapDiffChange contains the old and the new values of the key.
+type MapDiffChange[V any] struct {
+	Old V
+	New V
+}
+
+// MapDiff contains the difference between two maps.
+type MapDiff[K comparable, V any] struct {
+	// Added contains keys which exist only in the new map.
+	Added map[K]V
+	// Removed contains keys which exist only in the old map.
+	Removed map[K]V
+	// Changed contains keys which exist in both maps with different values.
+	Changed map[K]MapDiffChange[V]
+}
+
+// Empty returns true when maps are equal.
+func (d MapDiff[K, V]) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// MapDiffFn returns the difference between `oldMap` and `newMap`. Values are
+// compared by `equal`.
+func MapDiffFn[M ~map[K]V, K comparable, V any](oldMap, newMap M, equal func(a, b V) bool) MapDiff[K, V] {
+	res := MapDiff[K, V]{
+		Added:   make(map[K]V),
+		Removed: make(map[K]V),
+		Changed: make(map[K]MapDiffChange[V]),
+	}
+
+	for k, v1 := range oldMap {
+		v2, ok := newMap[k]
+		switch {
+		case !ok:
+			res.Removed[k] = v1
+		case !equal(v1, v2):
+			res.Changed[k] = MapDiffChange[V]{Old: v1, New: v2}
+		}
+	}
+
+	for k, v2 := range newMap {
+		if _, ok := oldMap[k]; !ok {
+			res.Added[k] = v2
+		}
+	}
+
+	return res
+}
+
+// MapDiffOf returns the difference between `oldMap` and `newMap`. See
+// MapDiffFn.
+func MapDiffOf[M ~map[K]V, K, V comparable](oldMap, newMap M) MapDiff[K, V] {
+	return MapDiffFn(oldMap, newMap, func(a, b V) bool { return a == b })
+}
+
+// MapDiffEntry contains one difference between two nested maps. Old is
+// invalid when the key was added, New is invalid when the key was removed.
+type MapDiffEntry struct {
+	// Path contains keys from the root map to the changed value.
+	Path []string
+	Old  NullVal[any]
+	New  NullVal[any]
+}
+
+// IsAdded returns true when the key exists only in the new map.
+func (e MapDiffEntry) IsAdded() bool {
+	return !e.Old.Valid && e.New.Valid
+}
+
+// IsRemoved returns true when the key exists only in the old map.
+func (e MapDiffEntry) IsRemoved() bool {
+	return e.Old.Valid && !e.New.Valid
+}
+
+// IsChanged returns true when the key exists in both maps.
+func (e MapDiffEntry) IsChanged() bool {
+	return e.Old.Valid && e.New.Valid
+}
+
+// MapDiffDeep returns the difference between `oldMap` and `newMap`. Values
+// which are `map[string]any` in both maps are compared recursively, other
+// values are compared by `equal` (reflect.DeepEqual is a good choice for
+// documents produced by json or yaml decoding). Entries are sorted by path.
+func MapDiffDeep(oldMap, newMap map[string]any, equal func(a, b any) bool) []MapDiffEntry {
+	return mapDiffDeep(nil, oldMap, newMap, equal, make([]MapDiffEntry, 0))
+}
+
+func mapDiffDeep(path []string, oldMap, newMap map[string]any, equal func(a, b any) bool, res []MapDiffEntry) []MapDiffEntry {
+	for _, k := range mapDeepKeys(oldMap, newMap) {
+		keyPath := mapPathAppend(path, k)
+		v1, ok1 := oldMap[k]
+		v2, ok2 := newMap[k]
+		n1, isMap1 := v1.(map[string]any)
+		n2, isMap2 := v2.(map[string]any)
+
+		switch {
+		case ok1 && ok2 && isMap1 && isMap2:
+			res = mapDiffDeep(keyPath, n1, n2, equal, res)
+		case ok1 && ok2 && equal(v1, v2):
+		default:
+			res = append(res, MapDiffEntry{
+				Path: keyPath,
+				Old:  NullVal[any]{Val: v1, Valid: ok1},
+				New:  NullVal[any]{Val: v2, Valid: ok2},
+			})
+		}
+	}
+
+	return res
+}
+
+// MapConflict contains the key which was changed differently in both maps
+// during the three-way merge. Invalid values mean the key is absent.
+type MapConflict struct {
+	// Path contains keys from the root map to the conflicted value.
+	Path   []string
+	Base   NullVal[any]
+	Ours   NullVal[any]
+	Theirs NullVal[any]
+}
+
+// MapMerge3Deep merges `ours` and `theirs` which both were derived from
+// `base`. Keys which are `map[string]any` on both sides are merged
+// recursively. A key changed only on one side gets the changed value. A key
+// changed on both sides gets the value when both sides agree. Otherwise, it
+// is a conflict: the result keeps the value from `ours` and the conflict is
+// reported. Nested maps are compared key by key, other values are compared
+// by `equal`, so `a == b` is a valid comparator for scalar leaves.
+// Conflicts are sorted by path.
+// Example: base {a:1, b:1}, ours {a:2, b:1}, theirs {a:1, b:3} => {a:2, b:3}
+func MapMerge3Deep(base, ours, theirs map[string]any, equal func(a, b any) bool) (map[string]any, []MapConflict) {
+	return mapMerge3Deep(nil, base, ours, theirs, equal, make([]MapConflict, 0))
+}
+
+func mapMerge3Deep(path []string, base, ours, theirs map[string]any, equal func(a, b any) bool, conflicts []MapConflict) (map[string]any, []MapConflict) {
+	eq := func(a, b NullVal[any]) bool {
+		if a.Valid != b.Valid {
+			return false
+		}
+
+		return !a.Valid || mapDeepEqual(a.Val, b.Val, equal)
+	}
+
+	res := make(map[string]any, Max(len(ours), len(theirs)))
+	for _, k := range mapDeepKeys(base, ours, theirs) {
+		b := MapGetNull(base, k)
+		o := MapGetNull(ours, k)
+		t := MapGetNull(theirs, k)
+		on, isMapO := o.Val.(map[string]any)
+		tn, isMapT := t.Val.(map[string]any)
+
+		var v NullVal[any]
+		switch {
+		case isMapO && isMapT:
+			bn, _ := b.Val.(map[string]any)
+			var merged map[string]any
+			merged, conflicts = mapMerge3Deep(mapPathAppend(path, k), bn, on, tn, equal, conflicts)
+			v = Null[any](merged)
+		case eq(o, t), eq(b, t):
+			v = o
+		case eq(b, o):
+			v = t
+		default:
+			conflicts = append(conflicts, MapConflict{
+				Path:   mapPathAppend(path, k),
+				Base:   b,
+				Ours:   o,
+				Theirs: t,
+			})
+			v = o
+		}
+
+		if v.Valid {
+			res[k] = v.Val
+		}
+	}
+
+	return res, conflicts
+}
+
+// mapDeepEqual compares nested maps key by key and other values by `equal`,
+// so `equal` is never called for maps.
+func mapDeepEqual(a, b any, equal func(a, b any) bool) bool {
+	an, isMapA := a.(map[string]any)
+	bn, isMapB := b.(map[string]any)
+	switch {
+	case isMapA && isMapB:
+		if len(an) != len(bn) {
+			return false
+		}
+
+		for k, v := range an {
+			v2, ok := bn[k]
+			if !ok || !mapDeepEqual(v, v2, equal) {
+				return false
+			}
+		}
+
+		return true
+	case isMapA || isMapB:
+		return false
+	default:
+		return equal(a, b)
+	}
+}
+
+// mapDeepKeys returns sorted unique keys of all maps.
+func mapDeepKeys(in ...map[string]any) []string {
+	keys := make([]string, 0)
+	for _, m := range in {
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return SliceUniqSorted(keys, func(a, b string) bool { return a < b })
+}
+
+// mapPathAppend returns a new path, which does not share memory with `path`.
+func mapPathAppend(path []string, key string) []string {
+	res := make([]string, len(path)+1)
+	copy(res, path)
+	res[len(path)] = key
+
+	return res
+}
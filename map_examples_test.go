@@ -2,6 +2,8 @@ package just_test
 
 import (
 	"fmt"
+	"reflect"
+
 	"github.com/kazhuravlev/just"
 )
 
@@ -36,3 +38,13 @@ func ExampleMapContainsKeysAll() {
 	fmt.Println(containsAllKeys)
 	// Output: true
 }
+
+func ExampleMapMerge3Deep() {
+	base := map[string]any{"host": "localhost", "port": 80}
+	ours := map[string]any{"host": "example.com", "port": 80}
+	theirs := map[string]any{"host": "localhost", "port": 8080}
+	result, conflicts := just.MapMerge3Deep(base, ours, theirs, reflect.DeepEqual)
+
+	fmt.Println(result, len(conflicts))
+	// Output: map[host:example.com port:8080] 0
+}
@@ -1,6 +1,7 @@
 package just_test
 
 import (
+	"reflect"
 	"sort"
 	"strconv"
 	"testing"
@@ -951,3 +952,238 @@ func TestMapCopy(t *testing.T) {
 		assert.Equal(t, 4, len(original))
 	})
 }
+
+func TestMapMergeDeep(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		name string
+		m1   map[string]any
+		m2   map[string]any
+		exp  map[string]any
+	}{
+		{
+			name: "empty",
+			m1:   nil,
+			m2:   nil,
+			exp:  map[string]any{},
+		},
+		{
+			name: "flat",
+			m1:   map[string]any{"a": 1, "b": 2},
+			m2:   map[string]any{"b": 3, "c": 4},
+			exp:  map[string]any{"a": 1, "b": 3, "c": 4},
+		},
+		{
+			name: "nested",
+			m1:   map[string]any{"a": map[string]any{"b": 1, "c": 2}},
+			m2:   map[string]any{"a": map[string]any{"c": 3, "d": map[string]any{"e": 4}}},
+			exp:  map[string]any{"a": map[string]any{"b": 1, "c": 3, "d": map[string]any{"e": 4}}},
+		},
+		{
+			name: "scalar_replaces_map",
+			m1:   map[string]any{"a": map[string]any{"b": 1}},
+			m2:   map[string]any{"a": nil},
+			exp:  map[string]any{"a": nil},
+		},
+		{
+			name: "map_replaces_scalar",
+			m1:   map[string]any{"a": 1},
+			m2:   map[string]any{"a": map[string]any{"b": 1}},
+			exp:  map[string]any{"a": map[string]any{"b": 1}},
+		},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(row.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := just.MapMergeDeep(row.m1, row.m2)
+			assert.Equal(t, row.exp, res)
+		})
+	}
+}
+
+func TestMapGetNull(t *testing.T) {
+	t.Parallel()
+
+	m := map[string]int{"a": 1, "zero": 0}
+	assert.Equal(t, just.Null(1), just.MapGetNull(m, "a"))
+	assert.Equal(t, just.Null(0), just.MapGetNull(m, "zero"))
+	assert.Equal(t, just.NullNull[int](), just.MapGetNull(m, "b"))
+	assert.Equal(t, just.NullNull[int](), just.MapGetNull(map[string]int(nil), "b"))
+}
+
+func TestMapDiffOf(t *testing.T) {
+	t.Parallel()
+
+	diff := just.MapDiffOf(
+		map[string]int{"a": 1, "b": 2, "c": 3},
+		map[string]int{"b": 2, "c": 4, "d": 5},
+	)
+	assert.Equal(t, map[string]int{"d": 5}, diff.Added)
+	assert.Equal(t, map[string]int{"a": 1}, diff.Removed)
+	assert.Equal(t, map[string]just.MapDiffChange[int]{"c": {Old: 3, New: 4}}, diff.Changed)
+	assert.False(t, diff.Empty())
+
+	assert.True(t, just.MapDiffOf(map[string]int{"a": 1}, map[string]int{"a": 1}).Empty())
+	assert.True(t, just.MapDiffOf[map[string]int](nil, nil).Empty())
+}
+
+func TestMapDiffFn(t *testing.T) {
+	t.Parallel()
+
+	diff := just.MapDiffFn(
+		map[string][]int{"a": {1}, "b": {2}},
+		map[string][]int{"a": {1}, "b": {3}},
+		func(a, b []int) bool { return just.SliceEqualUnordered(a, b) },
+	)
+	assert.Empty(t, diff.Added)
+	assert.Empty(t, diff.Removed)
+	assert.Equal(t, map[string]just.MapDiffChange[[]int]{"b": {Old: []int{2}, New: []int{3}}}, diff.Changed)
+}
+
+func TestMapDiffDeep(t *testing.T) {
+	t.Parallel()
+
+	oldMap := map[string]any{
+		"name": "svc",
+		"db": map[string]any{
+			"host": "localhost",
+			"port": 5432.,
+			"opts": map[string]any{"ssl": true},
+		},
+		"tags":   []any{"a", "b"},
+		"legacy": true,
+	}
+	newMap := map[string]any{
+		"name": "svc",
+		"db": map[string]any{
+			"host": "db.local",
+			"port": 5432.,
+			"opts": "none",
+		},
+		"tags":  []any{"a", "b"},
+		"debug": false,
+	}
+
+	diff := just.MapDiffDeep(oldMap, newMap, reflect.DeepEqual)
+	assert.Equal(t, []just.MapDiffEntry{
+		{Path: []string{"db", "host"}, Old: just.Null[any]("localhost"), New: just.Null[any]("db.local")},
+		{Path: []string{"db", "opts"}, Old: just.Null[any](map[string]any{"ssl": true}), New: just.Null[any]("none")},
+		{Path: []string{"debug"}, New: just.Null[any](false)},
+		{Path: []string{"legacy"}, Old: just.Null[any](true)},
+	}, diff)
+
+	assert.True(t, diff[0].IsChanged())
+	assert.True(t, diff[2].IsAdded())
+	assert.True(t, diff[3].IsRemoved())
+
+	assert.Empty(t, just.MapDiffDeep(oldMap, oldMap, reflect.DeepEqual))
+	assert.Empty(t, just.MapDiffDeep(nil, nil, reflect.DeepEqual))
+}
+
+func TestMapMerge3Deep(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no_conflicts", func(t *testing.T) {
+		base := map[string]any{
+			"a":   1,
+			"b":   1,
+			"del": 1,
+			"db":  map[string]any{"host": "h", "port": 1},
+		}
+		ours := map[string]any{
+			"a":   2,
+			"b":   1,
+			"new": 1,
+			"db":  map[string]any{"host": "h2", "port": 1},
+		}
+		theirs := map[string]any{
+			"a":   1,
+			"b":   3,
+			"del": 1,
+			"db":  map[string]any{"host": "h", "port": 2, "user": "u"},
+		}
+
+		res, conflicts := just.MapMerge3Deep(base, ours, theirs, reflect.DeepEqual)
+		assert.Empty(t, conflicts)
+		assert.Equal(t, map[string]any{
+			"a":   2,
+			"b":   3,
+			"new": 1,
+			"db":  map[string]any{"host": "h2", "port": 2, "user": "u"},
+		}, res)
+	})
+
+	t.Run("same_change", func(t *testing.T) {
+		res, conflicts := just.MapMerge3Deep(
+			map[string]any{"a": 1},
+			map[string]any{"a": 2, "b": 1},
+			map[string]any{"a": 2, "b": 1},
+			reflect.DeepEqual,
+		)
+		assert.Empty(t, conflicts)
+		assert.Equal(t, map[string]any{"a": 2, "b": 1}, res)
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		base := map[string]any{
+			"a":  1,
+			"b":  1,
+			"db": map[string]any{"host": "h"},
+		}
+		ours := map[string]any{
+			"a":  2,
+			"db": map[string]any{"host": "h2"},
+			"c":  1,
+		}
+		theirs := map[string]any{
+			"a":  3,
+			"b":  2,
+			"db": map[string]any{"host": "h3"},
+			"c":  2,
+		}
+
+		res, conflicts := just.MapMerge3Deep(base, ours, theirs, reflect.DeepEqual)
+		assert.Equal(t, map[string]any{
+			"a":  2,
+			"db": map[string]any{"host": "h2"},
+			"c":  1,
+		}, res)
+		assert.Equal(t, []just.MapConflict{
+			{Path: []string{"a"}, Base: just.Null[any](1), Ours: just.Null[any](2), Theirs: just.Null[any](3)},
+			{Path: []string{"b"}, Base: just.Null[any](1), Theirs: just.Null[any](2)},
+			{Path: []string{"c"}, Ours: just.Null[any](1), Theirs: just.Null[any](2)},
+			{Path: []string{"db", "host"}, Base: just.Null[any]("h"), Ours: just.Null[any]("h2"), Theirs: just.Null[any]("h3")},
+		}, conflicts)
+	})
+
+	t.Run("comparable_equal", func(t *testing.T) {
+		equal := func(a, b any) bool { return a == b }
+		base := map[string]any{
+			"db":    map[string]any{"host": "h", "port": 1},
+			"cache": map[string]any{"ttl": 1},
+			"log":   map[string]any{"level": "info"},
+		}
+		ours := map[string]any{
+			"db":    map[string]any{"host": "h2", "port": 1},
+			"cache": "disabled",
+			"log":   map[string]any{"level": "info"},
+		}
+		theirs := map[string]any{
+			"db":    map[string]any{"host": "h", "port": 2},
+			"cache": map[string]any{"ttl": 1},
+			"log":   map[string]any{"level": "debug"},
+		}
+
+		res, conflicts := just.MapMerge3Deep(base, ours, theirs, equal)
+		assert.Empty(t, conflicts)
+		assert.Equal(t, map[string]any{
+			"db":    map[string]any{"host": "h2", "port": 2},
+			"cache": "disabled",
+			"log":   map[string]any{"level": "debug"},
+		}, res)
+	})
+}
@@ -1,5 +1,12 @@
 package just
 
+import (
+	"math"
+	"unsafe"
+
+	"golang.org/x/exp/constraints"
+)
+
 // Max returns the max number from `in`.
 func Max[T number](in ...T) T {
 	if len(in) == 0 {
@@ -88,3 +95,543 @@ func Abs[T number](v T) T {
 
 	return v
 }
+
+// MaxBy returns the first max element from `in` according to `less`.
+// Returns SliceElem with Idx == -1 when `in` is empty.
+func MaxBy[T any](in []T, less func(a, b T) bool) SliceElem[T] {
+	_, maxElem := MinMaxBy(in, less)
+
+	return maxElem
+}
+
+// MinBy returns the first min element from `in` according to `less`.
+// Returns SliceElem with Idx == -1 when `in` is empty.
+func MinBy[T any](in []T, less func(a, b T) bool) SliceElem[T] {
+	minElem, _ := MinMaxBy(in, less)
+
+	return minElem
+}
+
+// MinMaxBy returns the first min and the first max elements from `in`
+// according to `less` in one pass. Returns SliceElem with Idx == -1 when
+// `in` is empty.
+func MinMaxBy[T any](in []T, less func(a, b T) bool) (SliceElem[T], SliceElem[T]) {
+	if len(in) == 0 {
+		return SliceElem[T]{Idx: -1}, SliceElem[T]{Idx: -1}
+	}
+
+	minIdx, maxIdx := 0, 0
+	for i := 1; i < len(in); i++ {
+		if less(in[i], in[minIdx]) {
+			minIdx = i
+		}
+
+		if less(in[maxIdx], in[i]) {
+			maxIdx = i
+		}
+	}
+
+	return SliceElem[T]{Idx: minIdx, Val: in[minIdx]}, SliceElem[T]{Idx: maxIdx, Val: in[maxIdx]}
+}
+
+// MaxByKey returns the first element from `in` with the max `key(elem)`.
+// Returns SliceElem with Idx == -1 when `in` is empty.
+func MaxByKey[T any, K constraints.Ordered](in []T, key func(T) K) SliceElem[T] {
+	_, maxElem := minMaxByKey(in, key)
+
+	return maxElem
+}
+
+// MinByKey returns the first element from `in` with the min `key(elem)`.
+// Returns SliceElem with Idx == -1 when `in` is empty.
+func MinByKey[T any, K constraints.Ordered](in []T, key func(T) K) SliceElem[T] {
+	minElem, _ := minMaxByKey(in, key)
+
+	return minElem
+}
+
+// ArgMax returns the first max element from `in`. Unlike Max it accepts any
+// ordered type and does not panic on empty input: returns SliceElem with
+// Idx == -1.
+func ArgMax[T constraints.Ordered](in []T) SliceElem[T] {
+	return MaxBy(in, func(a, b T) bool { return a < b })
+}
+
+// ArgMin returns the first min element from `in`. Unlike Min it accepts any
+// ordered type and does not panic on empty input: returns SliceElem with
+// Idx == -1.
+func ArgMin[T constraints.Ordered](in []T) SliceElem[T] {
+	return MinBy(in, func(a, b T) bool { return a < b })
+}
+
+// MinMax returns the first min and the first max elements from `in` in one
+// pass. Returns SliceElem with Idx == -1 when `in` is empty.
+func MinMax[T constraints.Ordered](in []T) (SliceElem[T], SliceElem[T]) {
+	return MinMaxBy(in, func(a, b T) bool { return a < b })
+}
+
+// minMaxByKey calls `key` only once for each element.
+func minMaxByKey[T any, K constraints.Ordered](in []T, key func(T) K) (SliceElem[T], SliceElem[T]) {
+	if len(in) == 0 {
+		return SliceElem[T]{Idx: -1}, SliceElem[T]{Idx: -1}
+	}
+
+	minIdx, maxIdx := 0, 0
+	minKey := key(in[0])
+	maxKey := minKey
+	for i := 1; i < len(in); i++ {
+		k := key(in[i])
+		if k < minKey {
+			minIdx, minKey = i, k
+		}
+
+		if k > maxKey {
+			maxIdx, maxKey = i, k
+		}
+	}
+
+	return SliceElem[T]{Idx: minIdx, Val: in[minIdx]}, SliceElem[T]{Idx: maxIdx, Val: in[maxIdx]}
+}
+
+// Mean returns the arithmetic mean of numbers from `in`. Returns NaN when
+// `in` is empty.
+func Mean[T number](in ...T) float64 {
+	if len(in) == 0 {
+		return math.NaN()
+	}
+
+	var acc float64
+	for i := range in {
+		acc += float64(in[i])
+	}
+
+	return acc / float64(len(in))
+}
+
+// Median returns the median of numbers from `in`. For even count returns
+// the mean of two middle numbers. Returns NaN when `in` is empty.
+func Median[T number](in ...T) float64 {
+	return Quantile(in, 0.5, QuantileLinear)
+}
+
+// Mode returns the most frequent values from `in` in ascending order.
+// Returns an empty slice when `in` is empty.
+// Example: [1,2,2,3,3] => [2,3]
+func Mode[T constraints.Ordered](in ...T) []T {
+	counts := make(map[T]int, len(in))
+	var maxCount int
+	for i := range in {
+		counts[in[i]]++
+		if counts[in[i]] > maxCount {
+			maxCount = counts[in[i]]
+		}
+	}
+
+	res := make([]T, 0)
+	for k, c := range counts {
+		if c == maxCount {
+			res = append(res, k)
+		}
+	}
+
+	SliceSort(res, func(a, b T) bool { return a < b })
+
+	return res
+}
+
+// Variance returns the population variance of numbers from `in`. Returns NaN
+// when `in` is empty.
+func Variance[T number](in ...T) float64 {
+	return variance(in, 0)
+}
+
+// VarianceSample returns the sample variance of numbers from `in` (with
+// Bessel's correction). Returns NaN when `in` contains less than 2 numbers.
+func VarianceSample[T number](in ...T) float64 {
+	return variance(in, 1)
+}
+
+// StdDev returns the population standard deviation of numbers from `in`.
+// Returns NaN when `in` is empty.
+func StdDev[T number](in ...T) float64 {
+	return math.Sqrt(Variance(in...))
+}
+
+// StdDevSample returns the sample standard deviation of numbers from `in`.
+// Returns NaN when `in` contains less than 2 numbers.
+func StdDevSample[T number](in ...T) float64 {
+	return math.Sqrt(VarianceSample(in...))
+}
+
+func variance[T number](in []T, ddof int) float64 {
+	var s RunningStats
+	for i := range in {
+		s.Add(float64(in[i]))
+	}
+
+	if ddof == 0 {
+		return s.Variance()
+	}
+
+	return s.VarianceSample()
+}
+
+// QuantileMethod defines how to interpolate the quantile which lies between
+// two numbers.
+type QuantileMethod int
+
+const (
+	// QuantileLinear interpolates linearly between two numbers.
+	QuantileLinear QuantileMethod = iota
+	// QuantileLower returns the lower number.
+	QuantileLower
+	// QuantileHigher returns the higher number.
+	QuantileHigher
+	// QuantileNearest returns the nearest number. Ties are resolved to the
+	// number with even index.
+	QuantileNearest
+	// QuantileMidpoint returns the mean of two numbers.
+	QuantileMidpoint
+)
+
+// Quantile returns the `q` quantile of numbers from `in`, where `q` is in
+// range [0, 1]. Returns NaN when `in` is empty or `q` is out of range.
+// The source slice is not modified.
+func Quantile[T number](in []T, q float64, method QuantileMethod) float64 {
+	if len(in) == 0 || q < 0 || q > 1 || math.IsNaN(q) {
+		return math.NaN()
+	}
+
+	sorted := SliceSortCopy(in, func(a, b T) bool { return a < b })
+
+	pos := q * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	loVal, hiVal := float64(sorted[lo]), float64(sorted[hi])
+
+	switch method {
+	case QuantileLower:
+		return loVal
+	case QuantileHigher:
+		return hiVal
+	case QuantileNearest:
+		return float64(sorted[int(math.RoundToEven(pos))])
+	case QuantileMidpoint:
+		return (loVal + hiVal) / 2
+	default:
+		return loVal + (hiVal-loVal)*(pos-float64(lo))
+	}
+}
+
+// Percentile returns the `p` percentile of numbers from `in`, where `p` is in
+// range [0, 100]. See Quantile.
+func Percentile[T number](in []T, p float64, method QuantileMethod) float64 {
+	return Quantile(in, p/100, method)
+}
+
+// HistogramBucket represents the range [Min, Max) of the histogram. The last
+// bucket also includes Max.
+type HistogramBucket struct {
+	Min   float64
+	Max   float64
+	Count int
+}
+
+// Histogram splits the range between min and max numbers from `in` into
+// `buckets` buckets of equal width and counts numbers in each bucket.
+// NaN and infinite numbers are skipped, because they do not belong to any
+// finite range. Returns an empty slice when `in` has no finite numbers. When
+// all numbers are equal, all of them are counted in the first bucket.
+func Histogram[T number](in []T, buckets int) []HistogramBucket {
+	if buckets <= 0 {
+		panic("buckets should be > 0")
+	}
+
+	values := make([]float64, 0, len(in))
+	for i := range in {
+		if v := float64(in[i]); !math.IsNaN(v) && !math.IsInf(v, 0) {
+			values = append(values, v)
+		}
+	}
+
+	if len(values) == 0 {
+		return make([]HistogramBucket, 0)
+	}
+
+	minElem, maxElem := MinMax(values)
+	lo, hi := minElem.Val, maxElem.Val
+
+	// hi-lo overflows for numbers close to the float64 limits, so bounds
+	// are interpolated and positions are computed from halves.
+	bound := func(i int) float64 {
+		f := float64(i) / float64(buckets)
+		return lo*(1-f) + hi*f
+	}
+
+	res := make([]HistogramBucket, buckets)
+	for i := range res {
+		res[i].Min = bound(i)
+		res[i].Max = bound(i + 1)
+	}
+
+	res[buckets-1].Max = hi
+
+	halfRange := hi/2 - lo/2
+	for _, v := range values {
+		idx := 0
+		if halfRange > 0 {
+			idx = Min(int((v/2-lo/2)/halfRange*float64(buckets)), buckets-1)
+		}
+
+		res[idx].Count++
+	}
+
+	return res
+}
+
+// RunningStats accumulates statistics of a stream of numbers without storing
+// them. It uses Welford's algorithm, which is numerically stable. The zero
+// value is ready to use. It is not safe for concurrent use.
+type RunningStats struct {
+	count int
+	mean  float64
+	m2    float64
+	min   float64
+	max   float64
+}
+
+// Add adds the number to the statistics.
+func (s *RunningStats) Add(v float64) {
+	s.count++
+	if s.count == 1 {
+		s.min, s.max = v, v
+	} else {
+		s.min = math.Min(s.min, v)
+		s.max = math.Max(s.max, v)
+	}
+
+	delta := v - s.mean
+	s.mean += delta / float64(s.count)
+	s.m2 += delta * (v - s.mean)
+}
+
+// Count returns the count of added numbers.
+func (s *RunningStats) Count() int {
+	return s.count
+}
+
+// Mean returns the mean of added numbers or NaN when nothing was added.
+func (s *RunningStats) Mean() float64 {
+	if s.count == 0 {
+		return math.NaN()
+	}
+
+	return s.mean
+}
+
+// Variance returns the population variance of added numbers or NaN when
+// nothing was added.
+func (s *RunningStats) Variance() float64 {
+	if s.count == 0 {
+		return math.NaN()
+	}
+
+	return s.m2 / float64(s.count)
+}
+
+// VarianceSample returns the sample variance of added numbers or NaN when
+// less than 2 numbers were added.
+func (s *RunningStats) VarianceSample() float64 {
+	if s.count < 2 {
+		return math.NaN()
+	}
+
+	return s.m2 / float64(s.count-1)
+}
+
+// StdDev returns the population standard deviation of added numbers or NaN
+// when nothing was added.
+func (s *RunningStats) StdDev() float64 {
+	return math.Sqrt(s.Variance())
+}
+
+// StdDevSample returns the sample standard deviation of added numbers or NaN
+// when less than 2 numbers were added.
+func (s *RunningStats) StdDevSample() float64 {
+	return math.Sqrt(s.VarianceSample())
+}
+
+// Min returns the min of added numbers or NaN when nothing was added.
+func (s *RunningStats) Min() float64 {
+	if s.count == 0 {
+		return math.NaN()
+	}
+
+	return s.min
+}
+
+// Max returns the max of added numbers or NaN when nothing was added.
+func (s *RunningStats) Max() float64 {
+	if s.count == 0 {
+		return math.NaN()
+	}
+
+	return s.max
+}
+
+// Clamp returns `v` limited to the range [lo, hi].
+func Clamp[T number](v, lo, hi T) T {
+	if v < lo {
+		return lo
+	}
+
+	if v > hi {
+		return hi
+	}
+
+	return v
+}
+
+// intLimits returns min and max values of T.
+func intLimits[T constraints.Integer]() (T, T) {
+	var zero T
+	bits := uint(unsafe.Sizeof(zero) * 8)
+	isSigned := ^zero < 0
+	if !isSigned {
+		return 0, ^zero
+	}
+
+	maxVal := T(1)<<(bits-1) - 1
+
+	return -maxVal - 1, maxVal
+}
+
+// AddChecked returns `a + b` and true or false on overflow.
+func AddChecked[T constraints.Integer](a, b T) (T, bool) {
+	c := a + b
+	if b > 0 && c < a || b < 0 && c > a {
+		return c, false
+	}
+
+	return c, true
+}
+
+// SubChecked returns `a - b` and true or false on overflow.
+func SubChecked[T constraints.Integer](a, b T) (T, bool) {
+	c := a - b
+	if b > 0 && c > a || b < 0 && c < a {
+		return c, false
+	}
+
+	return c, true
+}
+
+// MulChecked returns `a * b` and true or false on overflow.
+func MulChecked[T constraints.Integer](a, b T) (T, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+
+	c := a * b
+	// ^T(0) is -1 for signed types.
+	minVal, _ := intLimits[T]()
+	minusOne := ^T(0)
+	if minVal < 0 && (a == minusOne && b == minVal || b == minusOne && a == minVal) {
+		return c, false
+	}
+
+	if c/b != a {
+		return c, false
+	}
+
+	return c, true
+}
+
+// AbsChecked returns the abs value of `v` and true or false on overflow.
+// Overflow happens only for the min value of signed types.
+func AbsChecked[T constraints.Integer](v T) (T, bool) {
+	if v >= 0 {
+		return v, true
+	}
+
+	minVal, _ := intLimits[T]()
+	if v == minVal {
+		return v, false
+	}
+
+	return -v, true
+}
+
+// SumChecked returns the sum of numbers from `in` and true or false on
+// overflow of any intermediate sum.
+func SumChecked[T constraints.Integer](in ...T) (T, bool) {
+	var acc T
+	for i := range in {
+		var ok bool
+		acc, ok = AddChecked(acc, in[i])
+		if !ok {
+			return acc, false
+		}
+	}
+
+	return acc, true
+}
+
+// AddSat returns `a + b` limited to the range of T.
+func AddSat[T constraints.Integer](a, b T) T {
+	c, ok := AddChecked(a, b)
+	if ok {
+		return c
+	}
+
+	minVal, maxVal := intLimits[T]()
+
+	return If(b > 0, maxVal, minVal)
+}
+
+// SubSat returns `a - b` limited to the range of T.
+func SubSat[T constraints.Integer](a, b T) T {
+	c, ok := SubChecked(a, b)
+	if ok {
+		return c
+	}
+
+	minVal, maxVal := intLimits[T]()
+
+	return If(b > 0, minVal, maxVal)
+}
+
+// MulSat returns `a * b` limited to the range of T.
+func MulSat[T constraints.Integer](a, b T) T {
+	c, ok := MulChecked(a, b)
+	if ok {
+		return c
+	}
+
+	minVal, maxVal := intLimits[T]()
+
+	return If((a < 0) != (b < 0), minVal, maxVal)
+}
+
+// AbsSat returns the abs value of `v` limited to the range of T.
+func AbsSat[T constraints.Integer](v T) T {
+	c, ok := AbsChecked(v)
+	if ok {
+		return c
+	}
+
+	_, maxVal := intLimits[T]()
+
+	return maxVal
+}
+
+// SumSat returns the sum of numbers from `in`, where each addition is
+// limited to the range of T.
+func SumSat[T constraints.Integer](in ...T) T {
+	var acc T
+	for i := range in {
+		acc = AddSat(acc, in[i])
+	}
+
+	return acc
+}
@@ -26,3 +26,15 @@ func ExampleSum() {
 	fmt.Println(minValue)
 	// Output: 60
 }
+
+func ExampleMaxByKey() {
+	type user struct {
+		Name string
+		Age  int
+	}
+
+	users := []user{{Name: "joe", Age: 30}, {Name: "bob", Age: 40}}
+	oldest := just.MaxByKey(users, func(u user) int { return u.Age })
+	fmt.Println(oldest.Idx, oldest.Val.Name)
+	// Output: 1 bob
+}
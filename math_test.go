@@ -1,10 +1,14 @@
 package just_test
 
 import (
-	"github.com/kazhuravlev/just"
-	"github.com/stretchr/testify/assert"
 	"math"
+	"math/big"
 	"testing"
+	"unsafe"
+
+	"github.com/kazhuravlev/just"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/constraints"
 )
 
 func TestMax(t *testing.T) {
@@ -245,3 +249,462 @@ func TestAbs(t *testing.T) {
 	a := math.Copysign(0, -1)
 	assert.Equal(t, float64(0), just.Abs(a))
 }
+
+func TestMinMaxBy(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		Name string
+		Age  int
+	}
+
+	users := []user{
+		{Name: "joe", Age: 30},
+		{Name: "ann", Age: 20},
+		{Name: "bob", Age: 40},
+		{Name: "kim", Age: 20},
+		{Name: "tom", Age: 40},
+	}
+	byAge := func(a, b user) bool { return a.Age < b.Age }
+	age := func(u user) int { return u.Age }
+
+	t.Run("by_less", func(t *testing.T) {
+		assert.Equal(t, just.SliceElem[user]{Idx: 2, Val: users[2]}, just.MaxBy(users, byAge))
+		assert.Equal(t, just.SliceElem[user]{Idx: 1, Val: users[1]}, just.MinBy(users, byAge))
+
+		minElem, maxElem := just.MinMaxBy(users, byAge)
+		assert.Equal(t, 1, minElem.Idx)
+		assert.Equal(t, 2, maxElem.Idx)
+	})
+
+	t.Run("by_key", func(t *testing.T) {
+		assert.Equal(t, just.SliceElem[user]{Idx: 2, Val: users[2]}, just.MaxByKey(users, age))
+		assert.Equal(t, just.SliceElem[user]{Idx: 1, Val: users[1]}, just.MinByKey(users, age))
+
+		byName := func(u user) string { return u.Name }
+		assert.Equal(t, "tom", just.MaxByKey(users, byName).Val.Name)
+		assert.Equal(t, "ann", just.MinByKey(users, byName).Val.Name)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.False(t, just.MaxBy(nil, byAge).Ok())
+		assert.False(t, just.MinBy([]user{}, byAge).Ok())
+		assert.False(t, just.MaxByKey(nil, age).Ok())
+		assert.False(t, just.MinByKey(nil, age).Ok())
+
+		minElem, maxElem := just.MinMaxBy(nil, byAge)
+		assert.Equal(t, -1, minElem.Idx)
+		assert.Equal(t, -1, maxElem.Idx)
+	})
+
+	t.Run("key_called_once", func(t *testing.T) {
+		var calls int
+		just.MaxByKey(users, func(u user) int {
+			calls++
+			return u.Age
+		})
+		assert.Equal(t, len(users), calls)
+	})
+}
+
+func TestArgMinMax(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		name   string
+		in     []string
+		expMin just.SliceElem[string]
+		expMax just.SliceElem[string]
+	}{
+		{
+			name:   "empty",
+			in:     nil,
+			expMin: just.SliceElem[string]{Idx: -1},
+			expMax: just.SliceElem[string]{Idx: -1},
+		},
+		{
+			name:   "one",
+			in:     []string{"a"},
+			expMin: just.SliceElem[string]{Idx: 0, Val: "a"},
+			expMax: just.SliceElem[string]{Idx: 0, Val: "a"},
+		},
+		{
+			name:   "many",
+			in:     []string{"b", "a", "c", "a", "c"},
+			expMin: just.SliceElem[string]{Idx: 1, Val: "a"},
+			expMax: just.SliceElem[string]{Idx: 2, Val: "c"},
+		},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(row.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, row.expMin, just.ArgMin(row.in))
+			assert.Equal(t, row.expMax, just.ArgMax(row.in))
+
+			minElem, maxElem := just.MinMax(row.in)
+			assert.Equal(t, row.expMin, minElem)
+			assert.Equal(t, row.expMax, maxElem)
+		})
+	}
+}
+
+func TestMeanMedianMode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("mean", func(t *testing.T) {
+		assert.True(t, math.IsNaN(just.Mean[int]()))
+		assert.Equal(t, 2.5, just.Mean(1, 2, 3, 4))
+		assert.Equal(t, 2.0, just.Mean(2.0))
+		assert.Equal(t, float64(math.MaxInt64), just.Mean[int64](math.MaxInt64, math.MaxInt64))
+	})
+
+	t.Run("median", func(t *testing.T) {
+		assert.True(t, math.IsNaN(just.Median[int]()))
+		assert.Equal(t, 3.0, just.Median(5, 1, 3))
+		assert.Equal(t, 2.5, just.Median(4, 1, 3, 2))
+		assert.Equal(t, 7.0, just.Median[uint8](7))
+	})
+
+	t.Run("median_keeps_source", func(t *testing.T) {
+		in := []int{3, 1, 2}
+		just.Median(in...)
+		assert.Equal(t, []int{3, 1, 2}, in)
+	})
+
+	t.Run("mode", func(t *testing.T) {
+		assert.Equal(t, []int{}, just.Mode[int]())
+		assert.Equal(t, []int{2}, just.Mode(1, 2, 2, 3))
+		assert.Equal(t, []int{2, 3}, just.Mode(3, 1, 2, 2, 3))
+		assert.Equal(t, []string{"a", "b"}, just.Mode("b", "a"))
+	})
+}
+
+func TestVariance(t *testing.T) {
+	t.Parallel()
+
+	in := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	assert.InDelta(t, 4.0, just.Variance(in...), 1e-9)
+	assert.InDelta(t, 2.0, just.StdDev(in...), 1e-9)
+	assert.InDelta(t, 32.0/7, just.VarianceSample(in...), 1e-9)
+	assert.InDelta(t, math.Sqrt(32.0/7), just.StdDevSample(in...), 1e-9)
+
+	assert.Equal(t, 0.0, just.Variance(5))
+	assert.True(t, math.IsNaN(just.Variance[int]()))
+	assert.True(t, math.IsNaN(just.StdDev[int]()))
+	assert.True(t, math.IsNaN(just.VarianceSample(5)))
+	assert.True(t, math.IsNaN(just.StdDevSample(5)))
+}
+
+func TestQuantile(t *testing.T) {
+	t.Parallel()
+
+	in := []int{4, 1, 3, 2}
+
+	table := []struct {
+		name   string
+		q      float64
+		method just.QuantileMethod
+		exp    float64
+	}{
+		{name: "linear_0", q: 0, method: just.QuantileLinear, exp: 1},
+		{name: "linear_1", q: 1, method: just.QuantileLinear, exp: 4},
+		{name: "linear_40", q: 0.4, method: just.QuantileLinear, exp: 2.2},
+		{name: "lower_40", q: 0.4, method: just.QuantileLower, exp: 2},
+		{name: "higher_40", q: 0.4, method: just.QuantileHigher, exp: 3},
+		{name: "nearest_40", q: 0.4, method: just.QuantileNearest, exp: 2},
+		{name: "nearest_60", q: 0.6, method: just.QuantileNearest, exp: 3},
+		{name: "nearest_50_tie", q: 0.5, method: just.QuantileNearest, exp: 3},
+		{name: "midpoint_40", q: 0.4, method: just.QuantileMidpoint, exp: 2.5},
+		{name: "midpoint_exact", q: 1, method: just.QuantileMidpoint, exp: 4},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(row.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.InDelta(t, row.exp, just.Quantile(in, row.q, row.method), 1e-9)
+			assert.InDelta(t, row.exp, just.Percentile(in, row.q*100, row.method), 1e-9)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.True(t, math.IsNaN(just.Quantile([]int{}, 0.5, just.QuantileLinear)))
+		assert.True(t, math.IsNaN(just.Quantile(in, -0.1, just.QuantileLinear)))
+		assert.True(t, math.IsNaN(just.Quantile(in, 1.1, just.QuantileLinear)))
+		assert.True(t, math.IsNaN(just.Quantile(in, math.NaN(), just.QuantileLinear)))
+		assert.True(t, math.IsNaN(just.Percentile(in, 101, just.QuantileLinear)))
+	})
+}
+
+func TestHistogram(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid_buckets", func(t *testing.T) {
+		assert.Panics(t, func() {
+			just.Histogram([]int{1}, 0)
+		})
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, []just.HistogramBucket{}, just.Histogram([]int{}, 3))
+	})
+
+	t.Run("equal_width", func(t *testing.T) {
+		res := just.Histogram([]int{0, 1, 2, 5, 9, 10}, 2)
+		assert.Equal(t, []just.HistogramBucket{
+			{Min: 0, Max: 5, Count: 3},
+			{Min: 5, Max: 10, Count: 3},
+		}, res)
+	})
+
+	t.Run("same_values", func(t *testing.T) {
+		res := just.Histogram([]float64{2, 2, 2}, 3)
+		assert.Equal(t, []just.HistogramBucket{
+			{Min: 2, Max: 2, Count: 3},
+			{Min: 2, Max: 2, Count: 0},
+			{Min: 2, Max: 2, Count: 0},
+		}, res)
+	})
+
+	t.Run("non_finite_values_are_skipped", func(t *testing.T) {
+		res := just.Histogram([]float64{math.NaN(), 0, math.Inf(1), 10, math.Inf(-1)}, 2)
+		assert.Equal(t, []just.HistogramBucket{
+			{Min: 0, Max: 5, Count: 1},
+			{Min: 5, Max: 10, Count: 1},
+		}, res)
+
+		assert.Equal(t, []just.HistogramBucket{}, just.Histogram([]float64{math.NaN(), math.Inf(1)}, 3))
+	})
+
+	t.Run("extreme_values", func(t *testing.T) {
+		res := just.Histogram([]float64{-math.MaxFloat64, 0, math.MaxFloat64}, 2)
+		assert.Equal(t, []just.HistogramBucket{
+			{Min: -math.MaxFloat64, Max: 0, Count: 1},
+			{Min: 0, Max: math.MaxFloat64, Count: 2},
+		}, res)
+	})
+
+	t.Run("counts_all", func(t *testing.T) {
+		in := just.SliceRange(0.0, 1.0, 0.01)
+		res := just.Histogram(in, 7)
+		assert.Len(t, res, 7)
+		assert.Equal(t, len(in), just.Sum(just.SliceMap(res, func(b just.HistogramBucket) int { return b.Count })...))
+	})
+}
+
+func TestRunningStats(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+		var s just.RunningStats
+		assert.Equal(t, 0, s.Count())
+		assert.True(t, math.IsNaN(s.Mean()))
+		assert.True(t, math.IsNaN(s.Variance()))
+		assert.True(t, math.IsNaN(s.VarianceSample()))
+		assert.True(t, math.IsNaN(s.StdDev()))
+		assert.True(t, math.IsNaN(s.StdDevSample()))
+		assert.True(t, math.IsNaN(s.Min()))
+		assert.True(t, math.IsNaN(s.Max()))
+	})
+
+	t.Run("matches_batch", func(t *testing.T) {
+		in := []float64{2, 4, 4, 4, 5, 5, 7, 9, -3.5, 1e3}
+
+		var s just.RunningStats
+		for _, v := range in {
+			s.Add(v)
+		}
+
+		assert.Equal(t, len(in), s.Count())
+		assert.InDelta(t, just.Mean(in...), s.Mean(), 1e-9)
+		assert.InDelta(t, just.Variance(in...), s.Variance(), 1e-9)
+		assert.InDelta(t, just.VarianceSample(in...), s.VarianceSample(), 1e-9)
+		assert.InDelta(t, just.StdDev(in...), s.StdDev(), 1e-9)
+		assert.InDelta(t, just.StdDevSample(in...), s.StdDevSample(), 1e-9)
+		assert.Equal(t, -3.5, s.Min())
+		assert.Equal(t, 1e3, s.Max())
+	})
+
+	t.Run("numerically_stable", func(t *testing.T) {
+		var s just.RunningStats
+		for _, v := range []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16} {
+			s.Add(v)
+		}
+
+		assert.InDelta(t, 30.0, s.VarianceSample(), 1e-6)
+	})
+}
+
+func TestClamp(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 5, just.Clamp(5, 0, 10))
+	assert.Equal(t, 0, just.Clamp(-5, 0, 10))
+	assert.Equal(t, 10, just.Clamp(15, 0, 10))
+	assert.Equal(t, 0.5, just.Clamp(0.5, 0.0, 1.0))
+	assert.Equal(t, uint8(255), just.Clamp[uint8](255, 0, 255))
+}
+
+// checkIntOps compares checked and saturating operations with math/big for
+// all pairs of `values`.
+func checkIntOps[T constraints.Integer](t *testing.T, values []T) {
+	t.Helper()
+
+	minVal, maxVal := intLimitsBig[T]()
+	fit := func(v *big.Int) bool {
+		return v.Cmp(minVal) >= 0 && v.Cmp(maxVal) <= 0
+	}
+	sat := func(v *big.Int) T {
+		switch {
+		case v.Cmp(minVal) < 0:
+			return bigToInt[T](minVal)
+		case v.Cmp(maxVal) > 0:
+			return bigToInt[T](maxVal)
+		default:
+			return bigToInt[T](v)
+		}
+	}
+	check := func(op string, a, b T, exp *big.Int, res T, ok bool, resSat T) {
+		if fit(exp) != ok {
+			t.Fatalf("%s(%v, %v): expected ok=%t, got %t", op, a, b, fit(exp), ok)
+		}
+
+		if ok && bigToInt[T](exp) != res {
+			t.Fatalf("%s(%v, %v): expected %v, got %v", op, a, b, exp, res)
+		}
+
+		if sat(exp) != resSat {
+			t.Fatalf("%s(%v, %v): expected saturated %v, got %v", op, a, b, sat(exp), resSat)
+		}
+	}
+
+	for _, a := range values {
+		bigA := intToBig(a)
+
+		abs := new(big.Int).Abs(bigA)
+		res, ok := just.AbsChecked(a)
+		check("abs", a, 0, abs, res, ok, just.AbsSat(a))
+
+		for _, b := range values {
+			bigB := intToBig(b)
+
+			res, ok = just.AddChecked(a, b)
+			check("add", a, b, new(big.Int).Add(bigA, bigB), res, ok, just.AddSat(a, b))
+
+			res, ok = just.SubChecked(a, b)
+			check("sub", a, b, new(big.Int).Sub(bigA, bigB), res, ok, just.SubSat(a, b))
+
+			res, ok = just.MulChecked(a, b)
+			check("mul", a, b, new(big.Int).Mul(bigA, bigB), res, ok, just.MulSat(a, b))
+		}
+	}
+}
+
+func intLimitsBig[T constraints.Integer]() (*big.Int, *big.Int) {
+	var zero T
+	bits := uint(unsafe.Sizeof(zero) * 8)
+	if ^zero > 0 {
+		maxVal := new(big.Int).Lsh(big.NewInt(1), bits)
+		return big.NewInt(0), maxVal.Sub(maxVal, big.NewInt(1))
+	}
+
+	maxVal := new(big.Int).Lsh(big.NewInt(1), bits-1)
+	minVal := new(big.Int).Neg(maxVal)
+
+	return minVal, maxVal.Sub(maxVal, big.NewInt(1))
+}
+
+func intToBig[T constraints.Integer](v T) *big.Int {
+	if v < 0 {
+		return big.NewInt(int64(v))
+	}
+
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+func bigToInt[T constraints.Integer](v *big.Int) T {
+	if v.Sign() < 0 {
+		return T(v.Int64())
+	}
+
+	return T(v.Uint64())
+}
+
+// boundaryInts returns interesting values for T.
+func boundaryInts[T constraints.Integer]() []T {
+	minBig, maxBig := intLimitsBig[T]()
+	minVal, maxVal := bigToInt[T](minBig), bigToInt[T](maxBig)
+
+	res := []T{0, 1, 2, 3, minVal, minVal + 1, minVal + 2, maxVal, maxVal - 1, maxVal - 2, maxVal / 2, maxVal/2 + 1, minVal / 2}
+	if minVal < 0 {
+		res = append(res, ^T(0), ^T(1), minVal/2-1)
+	}
+
+	return res
+}
+
+func allInts[T int8 | uint8]() []T {
+	res := make([]T, 0, 256)
+	for i := 0; i < 256; i++ {
+		res = append(res, T(i))
+	}
+
+	return res
+}
+
+func TestIntOverflowExhaustive(t *testing.T) {
+	t.Parallel()
+
+	t.Run("int8", func(t *testing.T) { t.Parallel(); checkIntOps(t, allInts[int8]()) })
+	t.Run("uint8", func(t *testing.T) { t.Parallel(); checkIntOps(t, allInts[uint8]()) })
+}
+
+func TestIntOverflowBoundaries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("int16", func(t *testing.T) { checkIntOps(t, boundaryInts[int16]()) })
+	t.Run("int32", func(t *testing.T) { checkIntOps(t, boundaryInts[int32]()) })
+	t.Run("int64", func(t *testing.T) { checkIntOps(t, boundaryInts[int64]()) })
+	t.Run("int", func(t *testing.T) { checkIntOps(t, boundaryInts[int]()) })
+	t.Run("uint16", func(t *testing.T) { checkIntOps(t, boundaryInts[uint16]()) })
+	t.Run("uint32", func(t *testing.T) { checkIntOps(t, boundaryInts[uint32]()) })
+	t.Run("uint64", func(t *testing.T) { checkIntOps(t, boundaryInts[uint64]()) })
+	t.Run("uint", func(t *testing.T) { checkIntOps(t, boundaryInts[uint]()) })
+	t.Run("uintptr", func(t *testing.T) { checkIntOps(t, boundaryInts[uintptr]()) })
+}
+
+func TestSumChecked(t *testing.T) {
+	t.Parallel()
+
+	res, ok := just.SumChecked[int64]()
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), res)
+
+	res, ok = just.SumChecked[int64](1, 2, 3)
+	assert.True(t, ok)
+	assert.Equal(t, int64(6), res)
+
+	_, ok = just.SumChecked[int64](math.MaxInt64, 1, -1)
+	assert.False(t, ok)
+
+	_, ok = just.SumChecked[uint8](200, 100)
+	assert.False(t, ok)
+
+	assert.Equal(t, int64(math.MaxInt64), just.SumSat[int64](math.MaxInt64, math.MaxInt64))
+	assert.Equal(t, int64(math.MinInt64), just.SumSat[int64](math.MinInt64, -1))
+	assert.Equal(t, uint8(255), just.SumSat[uint8](200, 100))
+	assert.Equal(t, int8(126), just.SumSat[int8](100, 100, -1))
+}
+
+func TestAbsMinInt64(t *testing.T) {
+	t.Parallel()
+
+	_, ok := just.AbsChecked[int64](math.MinInt64)
+	assert.False(t, ok)
+	assert.Equal(t, int64(math.MaxInt64), just.AbsSat[int64](math.MinInt64))
+}
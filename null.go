@@ -1,11 +1,20 @@
 package just
 
 import (
+	"bytes"
 	"database/sql"
 	"database/sql/driver"
-	"errors"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/goccy/go-yaml"
+	"golang.org/x/exp/constraints"
 )
 
 // NullVal represents the nullable value for this type.
@@ -41,8 +50,215 @@ func (nv NullVal[T]) MarshalYAML() ([]byte, error) {
 	return yaml.Marshal(nv.Val)
 }
 
-// Scan implements the Scanner interface.
+// nullValStruct is a NullVal without methods. It is used to encode NullVal
+// in the struct form.
+type nullValStruct[T any] struct {
+	Val   T    `json:"v"`
+	Valid bool `json:"ok"`
+}
+
+// MarshalJSON implements the interface for marshaling json. NullVal is
+// marshaled as `{"v":...,"ok":...}`. See NullValPlain to marshal only the
+// value.
+func (nv NullVal[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(nullValStruct[T](nv))
+}
+
+// UnmarshalJSON implements the interface for unmarshalling json.
+func (nv *NullVal[T]) UnmarshalJSON(bb []byte) error {
+	var v nullValStruct[T]
+	if err := json.Unmarshal(bb, &v); err != nil {
+		return err
+	}
+
+	*nv = NullVal[T](v)
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. Useful for
+// map keys and query parameters. Invalid value is marshaled to an empty
+// text. Valid value is marshaled by its own MarshalText when it exists, as is
+// for strings, by strconv for numbers and bools and to json in other cases.
+// When this text is empty or starts with `\`, it is prefixed by `\`, so a
+// valid value never collides with the invalid one.
+// Example: Null("") => `\`, Null(`\n`) => `\\n`, NullNull() => empty text.
+func (nv NullVal[T]) MarshalText() ([]byte, error) {
+	if !nv.Valid {
+		return []byte{}, nil
+	}
+
+	bb, err := marshalText(nv.Val)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bb) == 0 || bb[0] == nullTextEscape {
+		bb = append([]byte{nullTextEscape}, bb...)
+	}
+
+	return bb, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. Empty text
+// is unmarshalled to invalid value. See NullVal.MarshalText for the format.
+func (nv *NullVal[T]) UnmarshalText(bb []byte) error {
+	if len(bb) == 0 {
+		nv.Val, nv.Valid = *new(T), false
+		return nil
+	}
+
+	if bb[0] == nullTextEscape {
+		bb = bb[1:]
+	}
+
+	var val T
+	if err := unmarshalText(bb, &val); err != nil {
+		return err
+	}
+
+	nv.Val, nv.Valid = val, true
+
+	return nil
+}
+
+// nullTextEscape is a prefix of the text of valid NullVal, which would be
+// empty or would start with this prefix otherwise.
+const nullTextEscape = '\\'
+
+// MarshalXML implements the xml.Marshaler interface. Invalid value is
+// omitted.
+func (nv NullVal[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !nv.Valid {
+		return nil
+	}
+
+	return e.EncodeElement(nv.Val, start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface. The element with
+// `xsi:nil="true"` attribute is unmarshalled to invalid value.
+func (nv *NullVal[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" && attr.Value == "true" {
+			nv.Val, nv.Valid = *new(T), false
+			return d.Skip()
+		}
+	}
+
+	var val T
+	if err := d.DecodeElement(&val, &start); err != nil {
+		return err
+	}
+
+	nv.Val, nv.Valid = val, true
+
+	return nil
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface. Invalid value is
+// omitted. See NullVal.MarshalText for the format.
+func (nv NullVal[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !nv.Valid {
+		return xml.Attr{}, nil
+	}
+
+	bb, err := nv.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	return xml.Attr{Name: name, Value: string(bb)}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface. See
+// NullVal.UnmarshalText for the format.
+func (nv *NullVal[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return nv.UnmarshalText([]byte(attr.Value))
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (nv NullVal[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(nullValStruct[T](nv)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (nv *NullVal[T]) GobDecode(bb []byte) error {
+	var v nullValStruct[T]
+	if err := gob.NewDecoder(bytes.NewReader(bb)).Decode(&v); err != nil {
+		return err
+	}
+
+	*nv = NullVal[T](v)
+
+	return nil
+}
+
+// IsZero returns true when NullVal.Valid == false. Allows to omit invalid
+// values by `omitzero` json tag option (go1.24+).
+func (nv NullVal[T]) IsZero() bool {
+	return !nv.Valid
+}
+
+// Plain returns the NullValPlain with the same value.
+func (nv NullVal[T]) Plain() NullValPlain[T] {
+	return NullValPlain[T]{NullVal: nv}
+}
+
+// NullValPlain is a NullVal which marshals to json as a plain value or `null`
+// instead of `{"v":...,"ok":...}`. It is useful for REST APIs.
+//
+// When used as a struct field:
+//   - the valid value is marshaled as is;
+//   - the invalid value is marshaled as `null` or omitted with `omitzero`;
+//   - both `null` and an absent field are unmarshalled to the invalid value.
+type NullValPlain[T any] struct {
+	NullVal[T]
+}
+
+// MarshalJSON implements the interface for marshaling json.
+func (nv NullValPlain[T]) MarshalJSON() ([]byte, error) {
+	if !nv.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(nv.Val)
+}
+
+// UnmarshalJSON implements the interface for unmarshalling json.
+func (nv *NullValPlain[T]) UnmarshalJSON(bb []byte) error {
+	if bytes.Equal(bytes.TrimSpace(bb), []byte("null")) {
+		nv.Val, nv.Valid = *new(T), false
+		return nil
+	}
+
+	var val T
+	if err := json.Unmarshal(bb, &val); err != nil {
+		return err
+	}
+
+	nv.Val, nv.Valid = val, true
+
+	return nil
+}
+
+// Scan implements the Scanner interface. NULL is scanned as invalid value,
+// even when T implements the Scanner interface itself.
+// Other values are converted to T by database/sql rules: numbers, strings,
+// []byte, bool and time.Time can be converted between each other when the
+// value is representable in T. Strings and []byte are also parsed into
+// time.Time.
 func (nv *NullVal[T]) Scan(value any) error {
+	if value == nil {
+		nv.Val, nv.Valid = *new(T), false
+		return nil
+	}
+
 	if v, ok := any(&nv.Val).(sql.Scanner); ok {
 		if err := v.Scan(value); err != nil {
 			nv.Val, nv.Valid = *new(T), false
@@ -53,18 +269,13 @@ func (nv *NullVal[T]) Scan(value any) error {
 		return nil
 	}
 
-	if value == nil {
-		nv.Val, nv.Valid = *new(T), true
-		return nil
-	}
-
-	v, ok := value.(T)
-	if !ok {
-		return errors.New("unexpected value type")
+	var val T
+	if err := convertAssign(&val, value); err != nil {
+		nv.Val, nv.Valid = *new(T), false
+		return err
 	}
 
-	nv.Valid = true
-	nv.Val = v
+	nv.Val, nv.Valid = val, true
 	return nil
 }
 
@@ -97,6 +308,54 @@ func (nv *NullVal[T]) SetDefault(val T) bool {
 	return true
 }
 
+// Filter returns NullVal as is when it is valid and `fn(val) == true`.
+// Returns invalid NullVal in other case.
+func (nv NullVal[T]) Filter(fn func(T) bool) NullVal[T] {
+	if !nv.Valid || !fn(nv.Val) {
+		return NullNull[T]()
+	}
+
+	return nv
+}
+
+// OrElse returns NullVal.Val when it is valid or `val` in other case.
+func (nv NullVal[T]) OrElse(val T) T {
+	if !nv.Valid {
+		return val
+	}
+
+	return nv.Val
+}
+
+// OrElseGet returns NullVal.Val when it is valid or the result of `fn` in
+// other case. `fn` is called only for invalid NullVal.
+func (nv NullVal[T]) OrElseGet(fn func() T) T {
+	if !nv.Valid {
+		return fn()
+	}
+
+	return nv.Val
+}
+
+// OrError returns NullVal.Val when it is valid or `err` in other case.
+func (nv NullVal[T]) OrError(err error) (T, error) {
+	if !nv.Valid {
+		return *new(T), err
+	}
+
+	return nv.Val, nil
+}
+
+// Pointer returns a pointer to a copy of NullVal.Val when it is valid or nil
+// in other case.
+func (nv NullVal[T]) Pointer() *T {
+	if !nv.Valid {
+		return nil
+	}
+
+	return Pointer(nv.Val)
+}
+
 // Null returns NullVal for `val` type, which are `NullVal.Valid == true`.
 func Null[T any](val T) NullVal[T] {
 	return NullVal[T]{
@@ -113,6 +372,106 @@ func NullNull[T any]() NullVal[T] {
 	}
 }
 
+// NullPlain returns NullValPlain for `val` type, which are
+// `NullValPlain.Valid == true`.
+func NullPlain[T any](val T) NullValPlain[T] {
+	return Null(val).Plain()
+}
+
+// NullFromOk returns NullVal for `val` which is valid when `ok == true`.
+// Useful for functions which return `(T, bool)`.
+func NullFromOk[T any](val T, ok bool) NullVal[T] {
+	if !ok {
+		return NullNull[T]()
+	}
+
+	return Null(val)
+}
+
+// NullFromPointer returns NullVal which contains the value from the pointer
+// or invalid NullVal when the pointer is nil.
+func NullFromPointer[T any](in *T) NullVal[T] {
+	if in == nil {
+		return NullNull[T]()
+	}
+
+	return Null(*in)
+}
+
+// NullFromWrapper returns NullVal from the protobuf wrapper like
+// *wrapperspb.StringValue. The nil wrapper is converted to invalid NullVal.
+// Example: NullFromWrapper[string](msg.GetName()).
+func NullFromWrapper[T any, W any, PW interface {
+	*W
+	GetValue() T
+}](in PW) NullVal[T] {
+	if in == nil {
+		return NullNull[T]()
+	}
+
+	return Null(in.GetValue())
+}
+
+// NullToWrapper returns the protobuf wrapper like *wrapperspb.StringValue
+// created by `fn` or nil when NullVal is invalid.
+// Example: NullToWrapper(nv, wrapperspb.String).
+func NullToWrapper[T any, W any](nv NullVal[T], fn func(T) *W) *W {
+	if !nv.Valid {
+		return nil
+	}
+
+	return fn(nv.Val)
+}
+
+// NullMap returns the NullVal with `fn(nv.Val)` when `nv` is valid or an
+// invalid NullVal in other case.
+func NullMap[T, V any](nv NullVal[T], fn func(T) V) NullVal[V] {
+	if !nv.Valid {
+		return NullNull[V]()
+	}
+
+	return Null(fn(nv.Val))
+}
+
+// NullFlatMap returns `fn(nv.Val)` when `nv` is valid or an invalid NullVal
+// in other case.
+func NullFlatMap[T, V any](nv NullVal[T], fn func(T) NullVal[V]) NullVal[V] {
+	if !nv.Valid {
+		return NullNull[V]()
+	}
+
+	return fn(nv.Val)
+}
+
+// NullEqual returns true when both values are invalid or both are valid and
+// contain equal values.
+func NullEqual[T comparable](a, b NullVal[T]) bool {
+	if a.Valid != b.Valid {
+		return false
+	}
+
+	return !a.Valid || a.Val == b.Val
+}
+
+// NullCompare returns -1, 0 or 1 when `a` is less, equal or greater than `b`.
+// Invalid value is less than any valid value.
+func NullCompare[T constraints.Ordered](a, b NullVal[T]) int {
+	switch {
+	case !a.Valid && !b.Valid:
+		return 0
+	case !a.Valid:
+		return -1
+	case !b.Valid:
+		return 1
+	case a.Val < b.Val:
+		return -1
+	case a.Val > b.Val:
+		return 1
+	default:
+		return 0
+	}
+}
+
 // NullDefaultFalse returns NullVal for this type with Valid=true only when
 // `val` is not equal to default value of type T.
 func NullDefaultFalse[T comparable](val T) NullVal[T] {
@@ -121,3 +480,245 @@ func NullDefaultFalse[T comparable](val T) NullVal[T] {
 		Valid: val != *new(T),
 	}
 }
+
+func marshalText(val any) ([]byte, error) {
+	if m, ok := val.(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	default:
+		return json.Marshal(val)
+	}
+}
+
+func unmarshalText(bb []byte, target any) error {
+	if u, ok := target.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText(bb)
+	}
+
+	rv := reflect.ValueOf(target).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(string(bb))
+	case reflect.Bool:
+		v, err := strconv.ParseBool(string(bb))
+		if err != nil {
+			return err
+		}
+
+		rv.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(string(bb), 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		rv.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v, err := strconv.ParseUint(string(bb), 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		rv.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(string(bb), rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		rv.SetFloat(v)
+	default:
+		if err := json.Unmarshal(bb, target); err != nil {
+			return fmt.Errorf("unmarshal %s from text: %w", rv.Type(), err)
+		}
+	}
+
+	return nil
+}
+
+// scanTimeLayouts are layouts which are used to parse time from strings.
+var scanTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// convertAssign copies `src` into `dest` like database/sql does. `dest` should
+// be a non-nil pointer, `src` should not be nil.
+func convertAssign(dest, src any) error {
+	switch s := src.(type) {
+	case string:
+		switch d := dest.(type) {
+		case *string:
+			*d = s
+			return nil
+		case *[]byte:
+			*d = []byte(s)
+			return nil
+		case *time.Time:
+			return parseScanTime(d, s, src)
+		}
+	case []byte:
+		switch d := dest.(type) {
+		case *string:
+			*d = string(s)
+			return nil
+		case *[]byte:
+			*d = append([]byte(nil), s...)
+			return nil
+		case *any:
+			*d = append([]byte(nil), s...)
+			return nil
+		case *time.Time:
+			return parseScanTime(d, string(s), src)
+		}
+	case time.Time:
+		switch d := dest.(type) {
+		case *time.Time:
+			*d = s
+			return nil
+		case *string:
+			*d = s.Format(time.RFC3339Nano)
+			return nil
+		case *[]byte:
+			*d = s.AppendFormat(nil, time.RFC3339Nano)
+			return nil
+		}
+	}
+
+	sv := reflect.ValueOf(src)
+	switch d := dest.(type) {
+	case *string:
+		switch sv.Kind() {
+		case reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			*d = scanAsString(sv)
+			return nil
+		}
+	case *[]byte:
+		switch sv.Kind() {
+		case reflect.String, reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			*d = []byte(scanAsString(sv))
+			return nil
+		}
+	case *bool:
+		bv, err := driver.Bool.ConvertValue(src)
+		if err != nil {
+			return fmt.Errorf("convert %T to bool: %w", src, err)
+		}
+
+		*d = bv.(bool)
+		return nil
+	case *any:
+		*d = src
+		return nil
+	}
+
+	dv := reflect.ValueOf(dest).Elem()
+	if sv.Type().AssignableTo(dv.Type()) {
+		dv.Set(sv)
+		return nil
+	}
+
+	if dv.Kind() == sv.Kind() && sv.Type().ConvertibleTo(dv.Type()) {
+		dv.Set(sv.Convert(dv.Type()))
+		return nil
+	}
+
+	s := scanAsString(sv)
+	switch dv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i64, err := strconv.ParseInt(s, 10, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("convert %T (%q) to %s: %w", src, s, dv.Type(), err)
+		}
+
+		dv.SetInt(i64)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u64, err := strconv.ParseUint(s, 10, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("convert %T (%q) to %s: %w", src, s, dv.Type(), err)
+		}
+
+		dv.SetUint(u64)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f64, err := strconv.ParseFloat(s, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("convert %T (%q) to %s: %w", src, s, dv.Type(), err)
+		}
+
+		dv.SetFloat(f64)
+		return nil
+	case reflect.String:
+		switch sv.Kind() {
+		case reflect.String, reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			dv.SetString(s)
+			return nil
+		case reflect.Slice:
+			if b, ok := src.([]byte); ok {
+				dv.SetString(string(b))
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("unsupported conversion from %T to %s", src, dv.Type())
+}
+
+func scanAsString(sv reflect.Value) string {
+	switch sv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(sv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(sv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(sv.Float(), 'g', -1, sv.Type().Bits())
+	case reflect.Bool:
+		return strconv.FormatBool(sv.Bool())
+	case reflect.String:
+		return sv.String()
+	}
+
+	if b, ok := sv.Interface().([]byte); ok {
+		return string(b)
+	}
+
+	return fmt.Sprintf("%v", sv.Interface())
+}
+
+func parseScanTime(dest *time.Time, s string, src any) error {
+	for _, layout := range scanTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			*dest = t
+			return nil
+		}
+	}
+
+	return fmt.Errorf("convert %T (%q) to time.Time: unknown time format", src, s)
+}
@@ -0,0 +1,29 @@
+//go:build go1.24
+
+package just_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kazhuravlev/just"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullValPlainOmitZero(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		Name  just.NullValPlain[string] `json:"name,omitzero"`
+		Email just.NullValPlain[string] `json:"email"`
+	}
+
+	bb, err := json.Marshal(request{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"email":null}`, string(bb))
+
+	bb, err = json.Marshal(request{Name: just.NullPlain(""), Email: just.NullPlain("a@b.c")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"","email":"a@b.c"}`, string(bb))
+}
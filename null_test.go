@@ -1,10 +1,21 @@
 package just_test
 
 import (
+	"bytes"
 	"database/sql"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
 	"github.com/kazhuravlev/just"
 	"github.com/stretchr/testify/assert"
-	"testing"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNullConstructor(t *testing.T) {
@@ -94,7 +105,7 @@ func TestNullConstructor(t *testing.T) {
 		v3 := just.NullNull[int]()
 		assert.NoError(t, v3.Scan(nil))
 		assert.Equal(t, 0, v3.Val)
-		assert.True(t, v3.Valid)
+		assert.False(t, v3.Valid)
 
 		v4 := just.NullNull[int]()
 		assert.Error(t, v4.Scan("this is not integer"))
@@ -280,3 +291,665 @@ func TestNullValYAML(t *testing.T) {
 		})
 	})
 }
+
+func TestNullValJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("struct_form_is_kept", func(t *testing.T) {
+		bb, err := json.Marshal(just.Null(42))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"v":42,"ok":true}`, string(bb))
+
+		bb, err = json.Marshal(just.NullNull[string]())
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"v":"","ok":false}`, string(bb))
+
+		var nv just.NullVal[int]
+		require.NoError(t, json.Unmarshal([]byte(`{"v":42,"ok":true}`), &nv))
+		assert.Equal(t, just.Null(42), nv)
+
+		assert.Error(t, json.Unmarshal([]byte(`{"v":"42","ok":true}`), &nv))
+	})
+
+	t.Run("plain_form", func(t *testing.T) {
+		type request struct {
+			Name  just.NullValPlain[string] `json:"name"`
+			Age   just.NullValPlain[int]    `json:"age"`
+			Email just.NullValPlain[string] `json:"email,omitempty"`
+		}
+
+		bb, err := json.Marshal(request{
+			Name: just.NullPlain("joe"),
+			Age:  just.NullNull[int]().Plain(),
+		})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"joe","age":null,"email":null}`, string(bb))
+
+		var req request
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"joe","age":null}`), &req))
+		assert.Equal(t, request{
+			Name:  just.NullPlain("joe"),
+			Age:   just.NullNull[int]().Plain(),
+			Email: just.NullNull[string]().Plain(),
+		}, req)
+
+		require.NoError(t, json.Unmarshal([]byte(`{"age":0}`), &req))
+		assert.Equal(t, just.NullPlain(0), req.Age)
+
+		assert.Error(t, json.Unmarshal([]byte(`{"age":"0"}`), &req))
+	})
+
+	t.Run("plain_form_promotes_methods", func(t *testing.T) {
+		nv := just.NullPlain(10)
+		val, ok := nv.ValueOk()
+		assert.Equal(t, 10, val)
+		assert.True(t, ok)
+
+		var scanned just.NullValPlain[int]
+		require.NoError(t, scanned.Scan(42))
+		assert.Equal(t, just.NullPlain(42), scanned)
+
+		bb, err := nv.MarshalYAML()
+		require.NoError(t, err)
+		assert.Equal(t, "10\n", string(bb))
+	})
+
+	t.Run("is_zero", func(t *testing.T) {
+		assert.True(t, just.NullNull[int]().IsZero())
+		assert.False(t, just.Null(0).IsZero())
+		assert.True(t, just.NullNull[int]().Plain().IsZero())
+	})
+}
+
+type textID struct {
+	n int
+}
+
+func (id textID) MarshalText() ([]byte, error) {
+	return []byte("id-" + strconv.Itoa(id.n)), nil
+}
+
+func (id *textID) UnmarshalText(bb []byte) error {
+	n, err := strconv.Atoi(strings.TrimPrefix(string(bb), "id-"))
+	id.n = n
+
+	return err
+}
+
+func TestNullValText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("marshal", func(t *testing.T) {
+		table := []struct {
+			name string
+			in   encoding.TextMarshaler
+			exp  string
+		}{
+			{name: "null", in: just.NullNull[int](), exp: ""},
+			{name: "string", in: just.Null("hello"), exp: "hello"},
+			{name: "empty_string", in: just.Null(""), exp: `\`},
+			{name: "escaped_string", in: just.Null(`\n`), exp: `\\n`},
+			{name: "int", in: just.Null(-42), exp: "-42"},
+			{name: "uint8", in: just.Null(uint8(255)), exp: "255"},
+			{name: "float", in: just.Null(1.5), exp: "1.5"},
+			{name: "bool", in: just.Null(true), exp: "true"},
+			{name: "text_marshaler", in: just.Null(textID{n: 7}), exp: "id-7"},
+			{name: "slice", in: just.Null([]int{1, 2}), exp: "[1,2]"},
+		}
+
+		for _, row := range table {
+			row := row
+			t.Run(row.name, func(t *testing.T) {
+				t.Parallel()
+
+				bb, err := row.in.MarshalText()
+				require.NoError(t, err)
+				assert.Equal(t, row.exp, string(bb))
+			})
+		}
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var i just.NullVal[int]
+		require.NoError(t, i.UnmarshalText([]byte("-42")))
+		assert.Equal(t, just.Null(-42), i)
+
+		require.NoError(t, i.UnmarshalText(nil))
+		assert.Equal(t, just.NullNull[int](), i)
+
+		assert.Error(t, i.UnmarshalText([]byte("abc")))
+
+		var i8 just.NullVal[int8]
+		assert.Error(t, i8.UnmarshalText([]byte("300")))
+
+		var u just.NullVal[uint]
+		require.NoError(t, u.UnmarshalText([]byte("300")))
+		assert.Equal(t, just.Null[uint](300), u)
+
+		var f just.NullVal[float32]
+		require.NoError(t, f.UnmarshalText([]byte("1.5")))
+		assert.Equal(t, just.Null[float32](1.5), f)
+
+		var b just.NullVal[bool]
+		require.NoError(t, b.UnmarshalText([]byte("true")))
+		assert.Equal(t, just.Null(true), b)
+
+		var s just.NullVal[string]
+		require.NoError(t, s.UnmarshalText([]byte("hello")))
+		assert.Equal(t, just.Null("hello"), s)
+		require.NoError(t, s.UnmarshalText([]byte(`\`)))
+		assert.Equal(t, just.Null(""), s)
+		require.NoError(t, s.UnmarshalText([]byte(`\\n`)))
+		assert.Equal(t, just.Null(`\n`), s)
+
+		var id just.NullVal[textID]
+		require.NoError(t, id.UnmarshalText([]byte("id-7")))
+		assert.Equal(t, just.Null(textID{n: 7}), id)
+
+		var sl just.NullVal[[]int]
+		require.NoError(t, sl.UnmarshalText([]byte("[1,2]")))
+		assert.Equal(t, just.Null([]int{1, 2}), sl)
+		assert.Error(t, sl.UnmarshalText([]byte("[1,")))
+	})
+
+	t.Run("map_keys", func(t *testing.T) {
+		in := map[just.NullVal[int]]string{
+			just.Null(1):         "one",
+			just.NullNull[int](): "none",
+		}
+
+		bb, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"1":"one","":"none"}`, string(bb))
+
+		var out map[just.NullVal[int]]string
+		require.NoError(t, json.Unmarshal(bb, &out))
+		assert.Equal(t, in, out)
+	})
+
+	t.Run("map_keys_empty_string", func(t *testing.T) {
+		in := map[just.NullVal[string]]int{
+			just.Null(""):           1,
+			just.NullNull[string](): 2,
+		}
+
+		bb, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"\\":1,"":2}`, string(bb))
+
+		var out map[just.NullVal[string]]int
+		require.NoError(t, json.Unmarshal(bb, &out))
+		assert.Equal(t, in, out)
+	})
+}
+
+type scanStatus string
+
+type scanCount int64
+
+func TestNullValScanConvert(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2024, 5, 6, 7, 8, 9, 10, time.UTC)
+
+	scan := func(t *testing.T, dest sql.Scanner, src any) {
+		t.Helper()
+		require.NoError(t, dest.Scan(src))
+	}
+
+	t.Run("null", func(t *testing.T) {
+		v := just.Null(10)
+		scan(t, &v, nil)
+		assert.Equal(t, just.NullNull[int](), v)
+
+		s := just.Null("x")
+		scan(t, &s, nil)
+		assert.Equal(t, just.NullNull[string](), s)
+
+		ns := just.Null(sql.NullString{String: "x", Valid: true})
+		scan(t, &ns, nil)
+		assert.Equal(t, just.NullNull[sql.NullString](), ns)
+		assert.False(t, ns.Valid)
+	})
+
+	t.Run("integers", func(t *testing.T) {
+		var i32 just.NullVal[int32]
+		scan(t, &i32, int64(42))
+		assert.Equal(t, just.Null[int32](42), i32)
+
+		var i8 just.NullVal[int8]
+		scan(t, &i8, []byte("-12"))
+		assert.Equal(t, just.Null[int8](-12), i8)
+
+		var u16 just.NullVal[uint16]
+		scan(t, &u16, "65535")
+		assert.Equal(t, just.Null[uint16](65535), u16)
+
+		var i just.NullVal[int]
+		scan(t, &i, int64(-1))
+		assert.Equal(t, just.Null(-1), i)
+
+		var named just.NullVal[scanCount]
+		scan(t, &named, int64(3))
+		assert.Equal(t, just.Null[scanCount](3), named)
+	})
+
+	t.Run("floats", func(t *testing.T) {
+		var f32 just.NullVal[float32]
+		scan(t, &f32, float64(1.5))
+		assert.Equal(t, just.Null[float32](1.5), f32)
+
+		var f64 just.NullVal[float64]
+		scan(t, &f64, int64(2))
+		assert.Equal(t, just.Null[float64](2), f64)
+
+		scan(t, &f64, []byte("3.25"))
+		assert.Equal(t, just.Null(3.25), f64)
+	})
+
+	t.Run("strings_and_bytes", func(t *testing.T) {
+		var s just.NullVal[string]
+		scan(t, &s, []byte("hello"))
+		assert.Equal(t, just.Null("hello"), s)
+
+		scan(t, &s, int64(42))
+		assert.Equal(t, just.Null("42"), s)
+
+		scan(t, &s, true)
+		assert.Equal(t, just.Null("true"), s)
+
+		scan(t, &s, ts)
+		assert.Equal(t, just.Null("2024-05-06T07:08:09.00000001Z"), s)
+
+		var named just.NullVal[scanStatus]
+		scan(t, &named, []byte("active"))
+		assert.Equal(t, just.Null[scanStatus]("active"), named)
+
+		src := []byte("bytes")
+		var b just.NullVal[[]byte]
+		scan(t, &b, src)
+		src[0] = 'B'
+		assert.Equal(t, just.Null([]byte("bytes")), b)
+
+		scan(t, &b, "str")
+		assert.Equal(t, just.Null([]byte("str")), b)
+
+		scan(t, &b, int64(7))
+		assert.Equal(t, just.Null([]byte("7")), b)
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		var b just.NullVal[bool]
+		scan(t, &b, int64(1))
+		assert.Equal(t, just.Null(true), b)
+
+		scan(t, &b, []byte("false"))
+		assert.Equal(t, just.Null(false), b)
+
+		scan(t, &b, "1")
+		assert.Equal(t, just.Null(true), b)
+	})
+
+	t.Run("time", func(t *testing.T) {
+		var tm just.NullVal[time.Time]
+		scan(t, &tm, ts)
+		assert.Equal(t, just.Null(ts), tm)
+
+		scan(t, &tm, "2024-05-06 07:08:09")
+		assert.Equal(t, just.Null(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)), tm)
+
+		scan(t, &tm, []byte("2024-05-06T07:08:09.00000001Z"))
+		assert.Equal(t, just.Null(ts), tm)
+
+		scan(t, &tm, "2024-05-06")
+		assert.Equal(t, just.Null(time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)), tm)
+	})
+
+	t.Run("any", func(t *testing.T) {
+		var a just.NullVal[any]
+		scan(t, &a, int64(1))
+		assert.Equal(t, just.Null[any](int64(1)), a)
+
+		scan(t, &a, []byte("x"))
+		assert.Equal(t, just.Null[any]([]byte("x")), a)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		table := []struct {
+			name   string
+			dest   sql.Scanner
+			src    any
+			errMsg string
+		}{
+			{
+				name:   "overflow",
+				dest:   &just.NullVal[int8]{},
+				src:    int64(300),
+				errMsg: `convert int64 ("300") to int8`,
+			},
+			{
+				name:   "negative_to_unsigned",
+				dest:   &just.NullVal[uint]{},
+				src:    int64(-1),
+				errMsg: `convert int64 ("-1") to uint`,
+			},
+			{
+				name:   "not_a_number",
+				dest:   &just.NullVal[float64]{},
+				src:    "abc",
+				errMsg: `convert string ("abc") to float64`,
+			},
+			{
+				name:   "not_a_bool",
+				dest:   &just.NullVal[bool]{},
+				src:    "maybe",
+				errMsg: "convert string to bool",
+			},
+			{
+				name:   "not_a_time",
+				dest:   &just.NullVal[time.Time]{},
+				src:    "yesterday",
+				errMsg: `convert string ("yesterday") to time.Time`,
+			},
+			{
+				name:   "unsupported",
+				dest:   &just.NullVal[time.Time]{},
+				src:    int64(1),
+				errMsg: "unsupported conversion from int64 to time.Time",
+			},
+		}
+
+		for _, row := range table {
+			row := row
+			t.Run(row.name, func(t *testing.T) {
+				t.Parallel()
+
+				err := row.dest.Scan(row.src)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), row.errMsg)
+			})
+		}
+
+		v := just.Null[int8](1)
+		require.Error(t, v.Scan(int64(300)))
+		assert.Equal(t, just.NullNull[int8](), v)
+	})
+}
+
+func TestNullValCombinators(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("not found")
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	t.Run("map", func(t *testing.T) {
+		assert.Equal(t, just.Null("10"), just.NullMap(just.Null(10), strconv.Itoa))
+		assert.Equal(t, just.NullNull[string](), just.NullMap(just.NullNull[int](), strconv.Itoa))
+	})
+
+	t.Run("flat_map", func(t *testing.T) {
+		parse := func(s string) just.NullVal[int] {
+			v, err := strconv.Atoi(s)
+			return just.NullFromOk(v, err == nil)
+		}
+
+		assert.Equal(t, just.Null(10), just.NullFlatMap(just.Null("10"), parse))
+		assert.Equal(t, just.NullNull[int](), just.NullFlatMap(just.Null("ten"), parse))
+		assert.Equal(t, just.NullNull[int](), just.NullFlatMap(just.NullNull[string](), parse))
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		assert.Equal(t, just.Null(2), just.Null(2).Filter(isEven))
+		assert.Equal(t, just.NullNull[int](), just.Null(1).Filter(isEven))
+		assert.Equal(t, just.NullNull[int](), just.NullNull[int]().Filter(isEven))
+	})
+
+	t.Run("or_else", func(t *testing.T) {
+		assert.Equal(t, 1, just.Null(1).OrElse(42))
+		assert.Equal(t, 42, just.NullNull[int]().OrElse(42))
+	})
+
+	t.Run("or_else_get", func(t *testing.T) {
+		var calls int
+		get := func() int {
+			calls++
+			return 42
+		}
+
+		assert.Equal(t, 1, just.Null(1).OrElseGet(get))
+		assert.Equal(t, 0, calls)
+		assert.Equal(t, 42, just.NullNull[int]().OrElseGet(get))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("or_error", func(t *testing.T) {
+		v, err := just.Null(1).OrError(errNotFound)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, v)
+
+		v, err = just.NullNull[int]().OrError(errNotFound)
+		assert.ErrorIs(t, err, errNotFound)
+		assert.Equal(t, 0, v)
+	})
+
+	t.Run("chain", func(t *testing.T) {
+		users := map[int]string{1: "joe", 2: ""}
+		name := func(id int) string {
+			return just.NullMap(
+				just.MapGetNull(users, id).Filter(func(s string) bool { return s != "" }),
+				strings.ToUpper,
+			).OrElse("anonymous")
+		}
+
+		assert.Equal(t, "JOE", name(1))
+		assert.Equal(t, "anonymous", name(2))
+		assert.Equal(t, "anonymous", name(3))
+	})
+
+	t.Run("equal", func(t *testing.T) {
+		assert.True(t, just.NullEqual(just.Null(1), just.Null(1)))
+		assert.True(t, just.NullEqual(just.NullNull[int](), just.NullNull[int]()))
+		assert.True(t, just.NullEqual(just.NullNull[int](), just.NullVal[int]{Val: 5}))
+		assert.False(t, just.NullEqual(just.Null(1), just.Null(2)))
+		assert.False(t, just.NullEqual(just.Null(0), just.NullNull[int]()))
+	})
+
+	t.Run("compare", func(t *testing.T) {
+		table := []struct {
+			a, b just.NullVal[string]
+			exp  int
+		}{
+			{a: just.NullNull[string](), b: just.NullNull[string](), exp: 0},
+			{a: just.NullNull[string](), b: just.Null(""), exp: -1},
+			{a: just.Null(""), b: just.NullNull[string](), exp: 1},
+			{a: just.Null("a"), b: just.Null("b"), exp: -1},
+			{a: just.Null("b"), b: just.Null("a"), exp: 1},
+			{a: just.Null("a"), b: just.Null("a"), exp: 0},
+		}
+
+		for _, row := range table {
+			assert.Equal(t, row.exp, just.NullCompare(row.a, row.b))
+		}
+	})
+
+	t.Run("from_ok", func(t *testing.T) {
+		assert.Equal(t, just.Null(1), just.NullFromOk(1, true))
+		assert.Equal(t, just.NullNull[int](), just.NullFromOk(1, false))
+		assert.Equal(t, just.Null(3), just.NullFromOk(just.SliceFindFirstElem([]int{1, 3}, 3).ValueOk()))
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		assert.Equal(t, just.Null(1), just.NullFromPointer(just.Pointer(1)))
+		assert.Equal(t, just.NullNull[int](), just.NullFromPointer[int](nil))
+		assert.Equal(t, just.Pointer(1), just.Null(1).Pointer())
+		assert.Nil(t, just.NullNull[int]().Pointer())
+	})
+}
+
+// stringValue has the same shape as wrapperspb.StringValue.
+type stringValue struct {
+	Value string
+}
+
+func (x *stringValue) GetValue() string {
+	if x == nil {
+		return ""
+	}
+
+	return x.Value
+}
+
+func newStringValue(v string) *stringValue {
+	return &stringValue{Value: v}
+}
+
+func TestNullValWrapper(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, just.Null("hi"), just.NullFromWrapper[string](newStringValue("hi")))
+	assert.Equal(t, just.Null(""), just.NullFromWrapper[string](newStringValue("")))
+	assert.Equal(t, just.NullNull[string](), just.NullFromWrapper[string]((*stringValue)(nil)))
+
+	assert.Equal(t, newStringValue("hi"), just.NullToWrapper(just.Null("hi"), newStringValue))
+	assert.Nil(t, just.NullToWrapper(just.NullNull[string](), newStringValue))
+}
+
+func TestNullValXML(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		XMLName xml.Name             `xml:"user"`
+		ID      just.NullVal[int]    `xml:"id,attr"`
+		Name    just.NullVal[string] `xml:"name"`
+		Age     just.NullVal[int]    `xml:"age"`
+	}
+
+	t.Run("marshal", func(t *testing.T) {
+		bb, err := xml.Marshal(user{ID: just.Null(7), Name: just.Null("joe"), Age: just.NullNull[int]()})
+		require.NoError(t, err)
+		assert.Equal(t, `<user id="7"><name>joe</name></user>`, string(bb))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		in := `<user xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><name xsi:nil="true"></name><age>30</age></user>`
+
+		var u user
+		require.NoError(t, xml.Unmarshal([]byte(in), &u))
+		assert.Equal(t, just.NullNull[int](), u.ID)
+		assert.Equal(t, just.NullNull[string](), u.Name)
+		assert.Equal(t, just.Null(30), u.Age)
+
+		assert.Error(t, xml.Unmarshal([]byte(`<user><age>x</age></user>`), &u))
+		assert.Error(t, xml.Unmarshal([]byte(`<user id="x"></user>`), &u))
+	})
+}
+
+func TestNullValRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	type formatFn func(t *testing.T, in just.NullVal[string]) just.NullVal[string]
+
+	type wrap struct {
+		V just.NullVal[string] `xml:"v"`
+	}
+
+	type wrapAttr struct {
+		V just.NullVal[string] `xml:"v,attr"`
+	}
+
+	formats := map[string]formatFn{
+		"json": func(t *testing.T, in just.NullVal[string]) just.NullVal[string] {
+			bb, err := json.Marshal(in)
+			require.NoError(t, err)
+
+			var out just.NullVal[string]
+			require.NoError(t, json.Unmarshal(bb, &out))
+			return out
+		},
+		"json_plain": func(t *testing.T, in just.NullVal[string]) just.NullVal[string] {
+			bb, err := json.Marshal(in.Plain())
+			require.NoError(t, err)
+
+			var out just.NullValPlain[string]
+			require.NoError(t, json.Unmarshal(bb, &out))
+			return out.NullVal
+		},
+		"text": func(t *testing.T, in just.NullVal[string]) just.NullVal[string] {
+			bb, err := in.MarshalText()
+			require.NoError(t, err)
+
+			var out just.NullVal[string]
+			require.NoError(t, out.UnmarshalText(bb))
+			return out
+		},
+		"xml": func(t *testing.T, in just.NullVal[string]) just.NullVal[string] {
+			bb, err := xml.Marshal(wrap{V: in})
+			require.NoError(t, err)
+
+			var out wrap
+			require.NoError(t, xml.Unmarshal(bb, &out))
+			return out.V
+		},
+		"xml_attr": func(t *testing.T, in just.NullVal[string]) just.NullVal[string] {
+			bb, err := xml.Marshal(wrapAttr{V: in})
+			require.NoError(t, err)
+
+			var out wrapAttr
+			require.NoError(t, xml.Unmarshal(bb, &out))
+			return out.V
+		},
+		"gob": func(t *testing.T, in just.NullVal[string]) just.NullVal[string] {
+			var buf bytes.Buffer
+			require.NoError(t, gob.NewEncoder(&buf).Encode(in))
+
+			var out just.NullVal[string]
+			require.NoError(t, gob.NewDecoder(&buf).Decode(&out))
+			return out
+		},
+		"sql": func(t *testing.T, in just.NullVal[string]) just.NullVal[string] {
+			v, err := in.Value()
+			require.NoError(t, err)
+
+			var out just.NullVal[string]
+			require.NoError(t, out.Scan(v))
+			return out
+		},
+		"wrapper": func(t *testing.T, in just.NullVal[string]) just.NullVal[string] {
+			return just.NullFromWrapper[string](just.NullToWrapper(in, newStringValue))
+		},
+	}
+
+	values := []just.NullVal[string]{
+		just.Null("hello world"),
+		just.Null(""),
+		just.Null(`\escaped`),
+		just.NullNull[string](),
+	}
+
+	for name, fn := range formats {
+		name, fn := name, fn
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, in := range values {
+				assert.Equal(t, in, fn(t, in))
+			}
+		})
+	}
+
+	t.Run("gob_struct", func(t *testing.T) {
+		type user struct {
+			Name just.NullVal[string]
+			Age  just.NullVal[int]
+		}
+
+		in := user{Name: just.Null("joe"), Age: just.NullNull[int]()}
+
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(in))
+
+		var out user
+		require.NoError(t, gob.NewDecoder(&buf).Decode(&out))
+		assert.Equal(t, in, out)
+	})
+}
@@ -0,0 +1,328 @@
+package just
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/goccy/go-yaml"
+)
+
+// Optional represents the value which can be absent, explicitly null or set.
+// It is useful for PATCH requests where an omitted field means "do not
+// change" and `null` means "reset". The zero value is absent.
+//
+// Null and absent values are distinguished when decoding JSON. goccy/go-yaml
+// decodes null nodes to the zero value without calling UnmarshalYAML, so
+// `field: null` in YAML is indistinguishable from an omitted field and is
+// decoded as absent.
+type Optional[T any] struct {
+	Val T
+	// Present is true when the value was provided, even as null.
+	Present bool
+	// Valid is true when the value is provided and it is not null.
+	Valid bool
+}
+
+// OptionalOf returns Optional for `val`, which is set.
+func OptionalOf[T any](val T) Optional[T] {
+	return Optional[T]{
+		Val:     val,
+		Present: true,
+		Valid:   true,
+	}
+}
+
+// OptionalNull returns Optional, which is explicitly null.
+func OptionalNull[T any]() Optional[T] {
+	return Optional[T]{
+		Val:     *new(T),
+		Present: true,
+		Valid:   false,
+	}
+}
+
+// OptionalAbsent returns Optional, which is absent.
+func OptionalAbsent[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// OptionalFromNull returns Optional for NullVal. Invalid NullVal is
+// converted to null.
+func OptionalFromNull[T any](nv NullVal[T]) Optional[T] {
+	if !nv.Valid {
+		return OptionalNull[T]()
+	}
+
+	return OptionalOf(nv.Val)
+}
+
+// OptionalFromPointer returns Optional for the pointer. The nil pointer is
+// converted to null.
+func OptionalFromPointer[T any](in *T) Optional[T] {
+	if in == nil {
+		return OptionalNull[T]()
+	}
+
+	return OptionalOf(*in)
+}
+
+// IsAbsent returns true when the value was not provided.
+func (o Optional[T]) IsAbsent() bool {
+	return !o.Present
+}
+
+// IsNull returns true when the value was provided as null.
+func (o Optional[T]) IsNull() bool {
+	return o.Present && !o.Valid
+}
+
+// IsSet returns true when the value was provided and it is not null.
+func (o Optional[T]) IsSet() bool {
+	return o.Present && o.Valid
+}
+
+// ValueOk returns the Optional.Val and true when the value is set.
+func (o Optional[T]) ValueOk() (T, bool) {
+	return o.Val, o.IsSet()
+}
+
+// ValueDefault returns the value when it is set or `defaultVal` in other
+// case.
+func (o Optional[T]) ValueDefault(defaultVal T) T {
+	return PointerUnwrapDefault(o.Pointer(), defaultVal)
+}
+
+// Pointer returns a pointer to a copy of the value when it is set or nil in
+// other case.
+func (o Optional[T]) Pointer() *T {
+	if !o.IsSet() {
+		return nil
+	}
+
+	return Pointer(o.Val)
+}
+
+// Null returns NullVal, which is valid only when the value is set.
+func (o Optional[T]) Null() NullVal[T] {
+	if !o.IsSet() {
+		return NullNull[T]()
+	}
+
+	return Null(o.Val)
+}
+
+// IsZero returns true when the value is absent. Allows to omit absent values
+// by `omitzero` json tag option (go1.24+).
+func (o Optional[T]) IsZero() bool {
+	return o.IsAbsent()
+}
+
+// MarshalJSON implements the interface for marshaling json. Absent and null
+// values are marshaled as `null`. Use `omitzero` to omit absent values.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.IsSet() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.Val)
+}
+
+// UnmarshalJSON implements the interface for unmarshalling json. It is not
+// called for omitted fields, so they stay absent.
+func (o *Optional[T]) UnmarshalJSON(bb []byte) error {
+	if bytes.Equal(bytes.TrimSpace(bb), []byte("null")) {
+		*o = OptionalNull[T]()
+		return nil
+	}
+
+	var val T
+	if err := json.Unmarshal(bb, &val); err != nil {
+		return err
+	}
+
+	*o = OptionalOf(val)
+
+	return nil
+}
+
+// MarshalYAML implements the interface for marshaling yaml.
+func (o Optional[T]) MarshalYAML() ([]byte, error) {
+	if !o.IsSet() {
+		return []byte("null"), nil
+	}
+
+	return yaml.Marshal(o.Val)
+}
+
+// UnmarshalYAML implements the interface for unmarshalling yaml. It decodes
+// null to the null value only when it is called directly: goccy/go-yaml does
+// not call it for null nodes, so they are decoded as absent. Use JSON when
+// null and absent fields should be distinguished.
+func (o *Optional[T]) UnmarshalYAML(bb []byte) error {
+	switch string(bytes.TrimSpace(bb)) {
+	case "", "null", "~":
+		*o = OptionalNull[T]()
+		return nil
+	}
+
+	var val T
+	if err := yaml.Unmarshal(bb, &val); err != nil {
+		return err
+	}
+
+	*o = OptionalOf(val)
+
+	return nil
+}
+
+// Scan implements the Scanner interface. NULL is scanned as null value.
+func (o *Optional[T]) Scan(value any) error {
+	var nv NullVal[T]
+	if err := nv.Scan(value); err != nil {
+		*o = OptionalAbsent[T]()
+		return err
+	}
+
+	*o = OptionalFromNull(nv)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface. Absent and null values are
+// represented as NULL.
+func (o Optional[T]) Value() (driver.Value, error) {
+	return o.Null().Value()
+}
+
+// optionalValue allows to read Optional of any type by reflection.
+type optionalValue interface {
+	optionalState() (val any, present, valid bool)
+}
+
+func (o Optional[T]) optionalState() (any, bool, bool) {
+	return o.Val, o.Present, o.Valid
+}
+
+// nullableValue allows to set NullVal of any type by reflection.
+type nullableValue interface {
+	setNullable(val any, valid bool) bool
+}
+
+func (nv *NullVal[T]) setNullable(val any, valid bool) bool {
+	if !valid {
+		*nv = NullNull[T]()
+		return true
+	}
+
+	v, ok := val.(T)
+	if !ok && val != nil {
+		return false
+	}
+
+	*nv = Null(v)
+
+	return true
+}
+
+var errOptionalType = errors.New("incompatible type")
+
+// OptionalApplyPatch copies all non-absent Optional fields from the `patch`
+// struct to the fields with the same names in the `target` struct. Target
+// field can be of type T, *T, NullVal[T] or Optional[T]. Null values reset
+// pointers and NullVal to nil/invalid; setting null to a plain T field is an
+// error. Returns FieldErrors for fields which can not be applied and an error
+// when `target` or `patch` is nil.
+func OptionalApplyPatch[P, T any](target *T, patch P) error {
+	if target == nil {
+		return errors.New("target should not be nil")
+	}
+
+	dst := reflect.ValueOf(target).Elem()
+	if dst.Kind() != reflect.Struct {
+		return fmt.Errorf("target should be a struct, got %s", dst.Type())
+	}
+
+	src := reflect.ValueOf(patch)
+	if src.Kind() == reflect.Pointer {
+		if src.IsNil() {
+			return errors.New("patch should not be nil")
+		}
+
+		src = src.Elem()
+	}
+
+	if src.Kind() != reflect.Struct {
+		return fmt.Errorf("patch should be a struct, got %T", patch)
+	}
+
+	var errs FieldErrors
+	for i := 0; i < src.NumField(); i++ {
+		field := src.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		opt, ok := src.Field(i).Interface().(optionalValue)
+		if !ok {
+			continue
+		}
+
+		val, present, valid := opt.optionalState()
+		if !present {
+			continue
+		}
+
+		dstField := dst.FieldByName(field.Name)
+		if !dstField.IsValid() || !dstField.CanSet() {
+			errs = append(errs, &FieldError{Path: field.Name, Err: errors.New("target field not found")})
+			continue
+		}
+
+		if err := optionalApplyField(dstField, src.Field(i), val, valid); err != nil {
+			errs = append(errs, &FieldError{Path: field.Name, Err: err})
+		}
+	}
+
+	if len(errs) != 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func optionalApplyField(dst, opt reflect.Value, val any, valid bool) error {
+	if dst.Type() == opt.Type() {
+		dst.Set(opt)
+		return nil
+	}
+
+	if n, ok := dst.Addr().Interface().(nullableValue); ok {
+		if !n.setNullable(val, valid) {
+			return fmt.Errorf("%w: %T to %s", errOptionalType, val, dst.Type())
+		}
+
+		return nil
+	}
+
+	rv := opt.FieldByName("Val")
+	switch {
+	case dst.Kind() == reflect.Pointer && !valid:
+		dst.Set(reflect.Zero(dst.Type()))
+	case !valid:
+		return fmt.Errorf("cannot set null to %s", dst.Type())
+	case dst.Kind() == reflect.Pointer && rv.Type().AssignableTo(dst.Type().Elem()):
+		ptr := reflect.New(dst.Type().Elem())
+		ptr.Elem().Set(rv)
+		dst.Set(ptr)
+	case rv.Type().AssignableTo(dst.Type()):
+		dst.Set(rv)
+	default:
+		return fmt.Errorf("%w: %s to %s", errOptionalType, rv.Type(), dst.Type())
+	}
+
+	return nil
+}
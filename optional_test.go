@@ -0,0 +1,279 @@
+package just_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/kazhuravlev/just"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionalStates(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		name     string
+		in       just.Optional[int]
+		isAbsent bool
+		isNull   bool
+		isSet    bool
+	}{
+		{name: "zero", in: just.Optional[int]{}, isAbsent: true},
+		{name: "absent", in: just.OptionalAbsent[int](), isAbsent: true},
+		{name: "null", in: just.OptionalNull[int](), isNull: true},
+		{name: "set", in: just.OptionalOf(0), isSet: true},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(row.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, row.isAbsent, row.in.IsAbsent())
+			assert.Equal(t, row.isAbsent, row.in.IsZero())
+			assert.Equal(t, row.isNull, row.in.IsNull())
+			assert.Equal(t, row.isSet, row.in.IsSet())
+
+			_, ok := row.in.ValueOk()
+			assert.Equal(t, row.isSet, ok)
+		})
+	}
+}
+
+func TestOptionalConversions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("null_val", func(t *testing.T) {
+		assert.Equal(t, just.OptionalOf(1), just.OptionalFromNull(just.Null(1)))
+		assert.Equal(t, just.OptionalNull[int](), just.OptionalFromNull(just.NullNull[int]()))
+
+		assert.Equal(t, just.Null(1), just.OptionalOf(1).Null())
+		assert.Equal(t, just.NullNull[int](), just.OptionalNull[int]().Null())
+		assert.Equal(t, just.NullNull[int](), just.OptionalAbsent[int]().Null())
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		assert.Equal(t, just.OptionalOf(1), just.OptionalFromPointer(just.Pointer(1)))
+		assert.Equal(t, just.OptionalNull[int](), just.OptionalFromPointer[int](nil))
+
+		assert.Equal(t, just.Pointer(1), just.OptionalOf(1).Pointer())
+		assert.Nil(t, just.OptionalNull[int]().Pointer())
+		assert.Nil(t, just.OptionalAbsent[int]().Pointer())
+	})
+
+	t.Run("value_default", func(t *testing.T) {
+		assert.Equal(t, 1, just.OptionalOf(1).ValueDefault(42))
+		assert.Equal(t, 42, just.OptionalNull[int]().ValueDefault(42))
+		assert.Equal(t, 42, just.OptionalAbsent[int]().ValueDefault(42))
+	})
+}
+
+func TestOptionalJSON(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		Name just.Optional[string] `json:"name"`
+		Age  just.Optional[int]    `json:"age"`
+	}
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var req request
+		require.NoError(t, json.Unmarshal([]byte(`{"name":null}`), &req))
+		assert.Equal(t, request{Name: just.OptionalNull[string]()}, req)
+
+		req = request{}
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"joe","age":0}`), &req))
+		assert.Equal(t, request{Name: just.OptionalOf("joe"), Age: just.OptionalOf(0)}, req)
+
+		assert.Error(t, json.Unmarshal([]byte(`{"age":"0"}`), &req))
+	})
+
+	t.Run("marshal", func(t *testing.T) {
+		bb, err := json.Marshal(request{Name: just.OptionalOf("joe"), Age: just.OptionalNull[int]()})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"joe","age":null}`, string(bb))
+
+		bb, err = json.Marshal(request{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":null,"age":null}`, string(bb))
+	})
+}
+
+func TestOptionalYAML(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		Name just.Optional[string] `yaml:"name"`
+		Age  just.Optional[int]    `yaml:"age"`
+	}
+
+	var req request
+	require.NoError(t, yaml.Unmarshal([]byte("name: joe\nage: 10\n"), &req))
+	assert.Equal(t, request{Name: just.OptionalOf("joe"), Age: just.OptionalOf(10)}, req)
+
+	// goccy/go-yaml does not call UnmarshalYAML for null fields, so they are
+	// decoded as absent.
+	req = request{}
+	require.NoError(t, yaml.Unmarshal([]byte("name: null\n"), &req))
+	assert.Equal(t, request{}, req)
+
+	var o just.Optional[int]
+	require.NoError(t, o.UnmarshalYAML([]byte("null")))
+	assert.Equal(t, just.OptionalNull[int](), o)
+
+	assert.Error(t, o.UnmarshalYAML([]byte("not a number")))
+
+	bb, err := just.OptionalOf("joe").MarshalYAML()
+	require.NoError(t, err)
+	assert.Equal(t, "joe\n", string(bb))
+
+	bb, err = just.OptionalAbsent[string]().MarshalYAML()
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(bb))
+}
+
+func TestOptionalSQL(t *testing.T) {
+	t.Parallel()
+
+	var o just.Optional[string]
+	require.NoError(t, o.Scan("hi"))
+	assert.Equal(t, just.OptionalOf("hi"), o)
+
+	require.NoError(t, o.Scan(nil))
+	assert.Equal(t, just.OptionalNull[string](), o)
+
+	assert.Error(t, o.Scan(struct{}{}))
+	assert.True(t, o.IsAbsent())
+
+	var ns just.Optional[sql.NullString]
+	require.NoError(t, ns.Scan("hi"))
+	assert.Equal(t, just.OptionalOf(sql.NullString{String: "hi", Valid: true}), ns)
+
+	v, err := just.OptionalOf("hi").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "hi", v)
+
+	v, err = just.OptionalNull[string]().Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	v, err = just.OptionalAbsent[string]().Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestOptionalApplyPatch(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		Name     string
+		Nickname *string
+		Age      just.NullVal[int]
+		Email    just.Optional[string]
+		Note     string
+	}
+
+	type userPatch struct {
+		Name     just.Optional[string]
+		Nickname just.Optional[string]
+		Age      just.Optional[int]
+		Email    just.Optional[string]
+		Note     string
+	}
+
+	newUser := func() user {
+		return user{
+			Name:     "joe",
+			Nickname: just.Pointer("j"),
+			Age:      just.Null(30),
+			Email:    just.OptionalOf("joe@example.com"),
+			Note:     "keep",
+		}
+	}
+
+	t.Run("absent_fields_are_skipped", func(t *testing.T) {
+		u := newUser()
+		require.NoError(t, just.OptionalApplyPatch(&u, userPatch{Note: "ignored"}))
+		assert.Equal(t, newUser(), u)
+	})
+
+	t.Run("set_fields", func(t *testing.T) {
+		u := newUser()
+		patch := userPatch{
+			Name:     just.OptionalOf("bob"),
+			Nickname: just.OptionalOf("b"),
+			Age:      just.OptionalOf(31),
+			Email:    just.OptionalNull[string](),
+		}
+		require.NoError(t, just.OptionalApplyPatch(&u, &patch))
+		assert.Equal(t, user{
+			Name:     "bob",
+			Nickname: just.Pointer("b"),
+			Age:      just.Null(31),
+			Email:    just.OptionalNull[string](),
+			Note:     "keep",
+		}, u)
+	})
+
+	t.Run("null_fields", func(t *testing.T) {
+		u := newUser()
+		patch := userPatch{
+			Nickname: just.OptionalNull[string](),
+			Age:      just.OptionalNull[int](),
+		}
+		require.NoError(t, just.OptionalApplyPatch(&u, patch))
+		assert.Nil(t, u.Nickname)
+		assert.Equal(t, just.NullNull[int](), u.Age)
+		assert.Equal(t, "joe", u.Name)
+	})
+
+	t.Run("null_to_plain_field", func(t *testing.T) {
+		u := newUser()
+		err := just.OptionalApplyPatch(&u, userPatch{Name: just.OptionalNull[string]()})
+		require.Error(t, err)
+
+		fieldErr, ok := just.ErrAs[just.FieldErrors](err)
+		require.True(t, ok)
+		require.Len(t, fieldErr, 1)
+		assert.Equal(t, "Name", fieldErr[0].Path)
+	})
+
+	t.Run("incompatible_fields", func(t *testing.T) {
+		type badPatch struct {
+			Name    just.Optional[int]
+			Age     just.Optional[string]
+			Unknown just.Optional[string]
+		}
+
+		u := newUser()
+		err := just.OptionalApplyPatch(&u, badPatch{
+			Name:    just.OptionalOf(1),
+			Age:     just.OptionalOf("x"),
+			Unknown: just.OptionalOf("x"),
+		})
+		require.Error(t, err)
+
+		fieldErr, ok := just.ErrAs[just.FieldErrors](err)
+		require.True(t, ok)
+		assert.Equal(t, []string{"Name", "Age", "Unknown"}, just.SliceMap(fieldErr, func(e *just.FieldError) string { return e.Path }))
+	})
+
+	t.Run("not_a_struct", func(t *testing.T) {
+		u := newUser()
+		assert.Error(t, just.OptionalApplyPatch(&u, 42))
+
+		i := 42
+		assert.Error(t, just.OptionalApplyPatch(&i, userPatch{}))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		u := newUser()
+		assert.Error(t, just.OptionalApplyPatch[*userPatch](&u, nil))
+		assert.Error(t, just.OptionalApplyPatch[any](&u, nil))
+		assert.Error(t, just.OptionalApplyPatch[userPatch, user](nil, userPatch{}))
+		assert.Equal(t, newUser(), u)
+	})
+}
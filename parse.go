@@ -0,0 +1,87 @@
+package just
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrUnsupportedFormat returned when the file format cannot be detected
+// by the file extension.
+var ErrUnsupportedFormat = errors.New("unsupported file format")
+
+// ParseOpts contains options for ParseTypeF.
+type ParseOpts[T any] struct {
+	// ExpandEnv replaces ${VAR} and $VAR in the file content by the values of
+	// environment variables before parsing.
+	ExpandEnv bool
+	// Json contains options for parsing json files.
+	Json JsonOpts
+	// Validate will be called for the parsed value. Return FieldError or
+	// FieldErrors to point to the invalid fields.
+	Validate func(*T) error
+}
+
+// ParseTypeF parse json or yaml file into specific T. The format is chosen
+// by the file extension: `.json`, `.yaml` or `.yml`.
+func ParseTypeF[T any](filename string, opts ParseOpts[T]) (*T, error) {
+	bb, err := readFile(filename, opts.ExpandEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *T
+	switch fileFormat(filename) {
+	case formatJSON:
+		res, err = JsonParseTypeOpts[T](bb, opts.Json)
+	case formatYAML:
+		res, err = YamlParseType[T](bb)
+	default:
+		return nil, fmt.Errorf("parse file %q: %w", filename, ErrUnsupportedFormat)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Validate != nil {
+		if err := opts.Validate(res); err != nil {
+			return nil, fmt.Errorf("validate: %w", err)
+		}
+	}
+
+	return res, nil
+}
+
+type format int
+
+const (
+	formatUnknown format = iota
+	formatJSON
+	formatYAML
+)
+
+func fileFormat(filename string) format {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		return formatJSON
+	case ".yaml", ".yml":
+		return formatYAML
+	default:
+		return formatUnknown
+	}
+}
+
+func readFile(filename string, expandEnv bool) ([]byte, error) {
+	bb, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	if expandEnv {
+		bb = []byte(os.ExpandEnv(string(bb)))
+	}
+
+	return bb, nil
+}
@@ -0,0 +1,113 @@
+package just_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kazhuravlev/just"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTempFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	filename := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(filename, []byte(content), 0o600))
+
+	return filename
+}
+
+func TestParseTypeF(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		filename := writeTempFile(t, "file.json", `{"id":42}`)
+
+		res, err := just.ParseTypeF(filename, just.ParseOpts[SomeType]{})
+		require.NoError(t, err)
+		require.Equal(t, SomeType{ID: 42}, *res)
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		for _, name := range []string{"file.yaml", "file.yml", "FILE.YML"} {
+			filename := writeTempFile(t, name, "id: 42\n")
+
+			res, err := just.ParseTypeF(filename, just.ParseOpts[SomeType]{})
+			require.NoError(t, err)
+			require.Equal(t, SomeType{ID: 42}, *res)
+		}
+	})
+
+	t.Run("unsupported_format", func(t *testing.T) {
+		filename := writeTempFile(t, "file.toml", `id = 42`)
+
+		res, err := just.ParseTypeF(filename, just.ParseOpts[SomeType]{})
+		require.ErrorIs(t, err, just.ErrUnsupportedFormat)
+		require.Nil(t, res)
+	})
+
+	t.Run("file_not_exists", func(t *testing.T) {
+		res, err := just.ParseTypeF("/path/not-exists/file.json", just.ParseOpts[SomeType]{})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("invalid_content", func(t *testing.T) {
+		filename := writeTempFile(t, "file.json", `{"id":"42"}`)
+
+		res, err := just.ParseTypeF(filename, just.ParseOpts[SomeType]{})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("expand_env", func(t *testing.T) {
+		t.Setenv("JUST_TEST_ID", "42")
+		filename := writeTempFile(t, "file.yaml", "id: ${JUST_TEST_ID}\n")
+
+		res, err := just.ParseTypeF(filename, just.ParseOpts[SomeType]{ExpandEnv: true})
+		require.NoError(t, err)
+		require.Equal(t, SomeType{ID: 42}, *res)
+
+		_, err = just.ParseTypeF(filename, just.ParseOpts[SomeType]{ExpandEnv: false})
+		require.Error(t, err)
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		filename := writeTempFile(t, "file.json", `{"id":-1}`)
+
+		opts := just.ParseOpts[SomeType]{
+			Validate: func(v *SomeType) error {
+				if v.ID < 0 {
+					return &just.FieldError{Path: "id", Err: errors.New("should be positive")}
+				}
+
+				return nil
+			},
+		}
+		res, err := just.ParseTypeF(filename, opts)
+		require.Error(t, err)
+		require.Nil(t, res)
+		assert.Equal(t, "validate: id: should be positive", err.Error())
+
+		fieldErr, ok := just.ErrAs[*just.FieldError](err)
+		require.True(t, ok)
+		assert.Equal(t, "id", fieldErr.Path)
+	})
+}
+
+func TestParseTypeFJsonOpts(t *testing.T) {
+	filename := writeTempFile(t, "file.json", `{"id":42,"name":"x"}`)
+
+	res, err := just.ParseTypeF(filename, just.ParseOpts[SomeType]{})
+	require.NoError(t, err)
+	require.Equal(t, SomeType{ID: 42}, *res)
+
+	res, err = just.ParseTypeF(filename, just.ParseOpts[SomeType]{Json: just.JsonOpts{DisallowUnknownFields: true}})
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	jsonErr, ok := just.ErrAs[*just.JsonError](err)
+	require.True(t, ok)
+	assert.Equal(t, "$.name", jsonErr.Path)
+}
@@ -0,0 +1,449 @@
+package just
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidPath returned when the path can not be parsed.
+	ErrInvalidPath = errors.New("invalid path")
+	// ErrPathNotContainer returned when the path goes through a value which
+	// is not a map or a slice.
+	ErrPathNotContainer = errors.New("value is not a map or slice")
+	// ErrPathNotSlice returned when the path contains an index of a value
+	// which is a map.
+	ErrPathNotSlice = errors.New("value is not a slice")
+	// ErrPathNotMap returned when the path contains a key of a value which
+	// is a slice.
+	ErrPathNotMap = errors.New("value is not a map")
+	// ErrPathIdxOutOfRange returned when the path sets the slice element
+	// after the end of the slice.
+	ErrPathIdxOutOfRange = errors.New("index out of range")
+	// ErrMapWalkSkip can be returned by the MapWalk visitor to skip children
+	// of the current value.
+	ErrMapWalkSkip = errors.New("skip children")
+)
+
+// pathSegment is one step of the path: a map key or a slice index.
+type pathSegment struct {
+	key   string
+	idx   int
+	isIdx bool
+}
+
+func (s pathSegment) String() string {
+	if s.isIdx {
+		return "[" + strconv.Itoa(s.idx) + "]"
+	}
+
+	return s.key
+}
+
+// sliceIdx returns the index of the slice element which this segment points
+// to. `-` points to the element after the last one.
+func (s pathSegment) sliceIdx(l int) (int, bool) {
+	if s.isIdx {
+		return s.idx, true
+	}
+
+	if s.key == "-" {
+		return l, true
+	}
+
+	idx, err := strconv.Atoi(s.key)
+	if err != nil || idx < 0 || strconv.Itoa(idx) != s.key {
+		return 0, false
+	}
+
+	return idx, true
+}
+
+// parsePath parses a JSON Pointer (RFC 6901) when the path starts with `/`,
+// or a dotted path like `db.hosts[0].port` otherwise. Empty path points to
+// the root.
+func parsePath(path string) ([]pathSegment, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	if strings.HasPrefix(path, "/") {
+		parts := strings.Split(path[1:], "/")
+		res := make([]pathSegment, len(parts))
+		for i, p := range parts {
+			if strings.Contains(strings.NewReplacer("~0", "", "~1", "").Replace(p), "~") {
+				return nil, fmt.Errorf("%w: %q: bad escape in %q", ErrInvalidPath, path, p)
+			}
+
+			res[i] = pathSegment{key: strings.NewReplacer("~1", "/", "~0", "~").Replace(p)}
+		}
+
+		return res, nil
+	}
+
+	res := make([]pathSegment, 0)
+	for _, p := range strings.Split(path, ".") {
+		key := p
+		var indexes string
+		if i := strings.IndexByte(p, '['); i != -1 {
+			key, indexes = p[:i], p[i:]
+		}
+
+		if key == "" && (indexes == "" || len(res) == 0) {
+			return nil, fmt.Errorf("%w: %q: empty key", ErrInvalidPath, path)
+		}
+
+		if key != "" {
+			res = append(res, pathSegment{key: key})
+		}
+
+		for indexes != "" {
+			end := strings.IndexByte(indexes, ']')
+			if indexes[0] != '[' || end == -1 {
+				return nil, fmt.Errorf("%w: %q: bad index %q", ErrInvalidPath, path, indexes)
+			}
+
+			idx, err := strconv.Atoi(indexes[1:end])
+			if err != nil || idx < 0 {
+				return nil, fmt.Errorf("%w: %q: bad index %q", ErrInvalidPath, path, indexes[:end+1])
+			}
+
+			res = append(res, pathSegment{idx: idx, isIdx: true})
+			indexes = indexes[end+1:]
+		}
+	}
+
+	return res, nil
+}
+
+// joinPath returns the dotted path of segments.
+func joinPath(segments []pathSegment) string {
+	var sb strings.Builder
+	for i, s := range segments {
+		if i != 0 && !s.isIdx {
+			sb.WriteByte('.')
+		}
+
+		sb.WriteString(s.String())
+	}
+
+	return sb.String()
+}
+
+// MapPathGet returns the value from the nested document `m` by `path`. The
+// path is a JSON Pointer like `/db/hosts/0/port` when it starts with `/`,
+// or a dotted path like `db.hosts[0].port` (`db.hosts.0.port` also works)
+// otherwise. Empty path points to `m` itself.
+func MapPathGet(m map[string]any, path string) (any, bool) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	var cur any = m
+	for _, s := range segments {
+		switch c := cur.(type) {
+		case map[string]any:
+			v, ok := c[s.key]
+			if s.isIdx || !ok {
+				return nil, false
+			}
+
+			cur = v
+		case []any:
+			idx, ok := s.sliceIdx(len(c))
+			if !ok || idx >= len(c) {
+				return nil, false
+			}
+
+			cur = c[idx]
+		default:
+			return nil, false
+		}
+	}
+
+	return cur, true
+}
+
+// MapPathGetAs returns the value from the nested document `m` by `path`
+// when this value has type T. Note that json decoding produces float64 for
+// numbers, []any for arrays and map[string]any for objects.
+// See MapPathGet.
+func MapPathGetAs[T any](m map[string]any, path string) (T, bool) {
+	var target T
+	v, ok := MapPathGet(m, path)
+	if !ok {
+		return target, false
+	}
+
+	target, ok = v.(T)
+
+	return target, ok
+}
+
+// MapPathGetDefault returns the value from the nested document `m` by `path`
+// when this value has type T or `defaultVal` otherwise. See MapPathGetAs.
+func MapPathGetDefault[T any](m map[string]any, path string, defaultVal T) T {
+	if v, ok := MapPathGetAs[T](m, path); ok {
+		return v
+	}
+
+	return defaultVal
+}
+
+// MapPathSet sets the value in the nested document `m` by `path`. Missing
+// maps are created, slices are created for dotted index segments like
+// `[0]`. The index equal to the len of the slice appends to the slice, as
+// well as the JSON Pointer segment `-`. Returns ErrPathIdxOutOfRange for
+// greater indexes. See MapPathGet.
+func MapPathSet(m map[string]any, path string, val any) error {
+	segments, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	if len(segments) == 0 {
+		return fmt.Errorf("%w: can not set the root", ErrInvalidPath)
+	}
+
+	_, err = mapPathSet(m, segments, 0, val)
+
+	return err
+}
+
+func mapPathSet(cur any, segments []pathSegment, i int, val any) (any, error) {
+	if i == len(segments) {
+		return val, nil
+	}
+
+	s := segments[i]
+	if cur == nil {
+		if s.isIdx || s.key == "-" {
+			cur = make([]any, 0)
+		} else {
+			cur = make(map[string]any)
+		}
+	}
+
+	switch c := cur.(type) {
+	case map[string]any:
+		if s.isIdx {
+			return nil, fmt.Errorf("%q: %w", joinPath(segments[:i]), ErrPathNotSlice)
+		}
+
+		child, err := mapPathSet(c[s.key], segments, i+1, val)
+		if err != nil {
+			return nil, err
+		}
+
+		c[s.key] = child
+
+		return c, nil
+	case []any:
+		idx, ok := s.sliceIdx(len(c))
+		if !ok {
+			return nil, fmt.Errorf("%q: %w", joinPath(segments[:i]), ErrPathNotMap)
+		}
+
+		if idx > len(c) {
+			return nil, fmt.Errorf("%q: %w: %d of %d", joinPath(segments[:i+1]), ErrPathIdxOutOfRange, idx, len(c))
+		}
+
+		var prev any
+		if idx < len(c) {
+			prev = c[idx]
+		}
+
+		child, err := mapPathSet(prev, segments, i+1, val)
+		if err != nil {
+			return nil, err
+		}
+
+		if idx == len(c) {
+			c = append(c, child)
+		} else {
+			c[idx] = child
+		}
+
+		return c, nil
+	default:
+		return nil, fmt.Errorf("%q: %w", joinPath(segments[:i]), ErrPathNotContainer)
+	}
+}
+
+// MapPathDelete removes the value from the nested document `m` by `path`.
+// Elements of slices after the removed one are shifted. Returns false when
+// the value does not exist. See MapPathGet.
+func MapPathDelete(m map[string]any, path string) bool {
+	segments, err := parsePath(path)
+	if err != nil || len(segments) == 0 {
+		return false
+	}
+
+	_, ok := mapPathDelete(m, segments)
+
+	return ok
+}
+
+func mapPathDelete(cur any, segments []pathSegment) (any, bool) {
+	s := segments[0]
+	switch c := cur.(type) {
+	case map[string]any:
+		child, exists := c[s.key]
+		if s.isIdx || !exists {
+			return c, false
+		}
+
+		if len(segments) == 1 {
+			delete(c, s.key)
+			return c, true
+		}
+
+		child, ok := mapPathDelete(child, segments[1:])
+		c[s.key] = child
+
+		return c, ok
+	case []any:
+		idx, ok := s.sliceIdx(len(c))
+		if !ok || idx >= len(c) {
+			return c, false
+		}
+
+		if len(segments) == 1 {
+			return append(c[:idx], c[idx+1:]...), true
+		}
+
+		child, ok := mapPathDelete(c[idx], segments[1:])
+		c[idx] = child
+
+		return c, ok
+	default:
+		return cur, false
+	}
+}
+
+// MapWalk calls `fn` for each value of the nested document `m` in depth-first
+// order: first for the container, then for its children. Map keys are
+// visited in sorted order. The path is dotted, like `db.hosts[0].port`.
+// When `fn` returns ErrMapWalkSkip children of the current value are
+// skipped, any other error stops the walk and is returned.
+func MapWalk(m map[string]any, fn func(path string, val any) error) error {
+	return mapWalk(nil, m, fn)
+}
+
+func mapWalk(path []pathSegment, cur any, fn func(path string, val any) error) error {
+	visit := func(s pathSegment, v any) error {
+		childPath := append(path[:len(path):len(path)], s)
+		if err := fn(joinPath(childPath), v); err != nil {
+			if errors.Is(err, ErrMapWalkSkip) {
+				return nil
+			}
+
+			return err
+		}
+
+		return mapWalk(childPath, v, fn)
+	}
+
+	switch c := cur.(type) {
+	case map[string]any:
+		keys := MapGetKeys(c)
+		sort.Strings(keys)
+		for _, k := range keys {
+			if err := visit(pathSegment{key: k}, c[k]); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i := range c {
+			if err := visit(pathSegment{idx: i, isIdx: true}, c[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// MapFlatten returns the flat map, where keys are dotted paths of leaf values
+// of the nested document `m`. Empty maps and slices are leaf values too.
+// Keys which contain `.` or `[` can not be restored by MapUnflatten.
+// Example: {a:{b:1, c:[2]}} => {"a.b":1, "a.c[0]":2}
+func MapFlatten(m map[string]any) map[string]any {
+	res := make(map[string]any)
+	_ = MapWalk(m, func(path string, val any) error {
+		switch v := val.(type) {
+		case map[string]any:
+			if len(v) != 0 {
+				return nil
+			}
+		case []any:
+			if len(v) != 0 {
+				return nil
+			}
+		}
+
+		res[path] = val
+
+		return nil
+	})
+
+	return res
+}
+
+// MapUnflatten returns the nested document from the flat map produced by
+// MapFlatten. Returns an error when keys conflict with each other or indexes
+// of a slice have gaps.
+// Example: {"a.b":1, "a.c[0]":2} => {a:{b:1, c:[2]}}
+func MapUnflatten(m map[string]any) (map[string]any, error) {
+	paths := make([]Pair[[]pathSegment, any], 0, len(m))
+	for k, v := range m {
+		segments, err := parsePath(k)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(segments) == 0 {
+			return nil, fmt.Errorf("%w: can not set the root", ErrInvalidPath)
+		}
+
+		paths = append(paths, Pair[[]pathSegment, any]{First: segments, Second: v})
+	}
+
+	// Indexes are compared as numbers, so elements are appended to slices
+	// in order.
+	sort.Slice(paths, func(i, j int) bool {
+		return pathSegmentsLess(paths[i].First, paths[j].First)
+	})
+
+	res := make(map[string]any, len(m))
+	for _, p := range paths {
+		if _, err := mapPathSet(res, p.First, 0, p.Second); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// pathSegmentsLess compares paths segment by segment. Indexes are compared
+// as numbers and are less than keys.
+func pathSegmentsLess(a, b []pathSegment) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		switch sa, sb := a[i], b[i]; {
+		case sa.isIdx && sb.isIdx:
+			if sa.idx != sb.idx {
+				return sa.idx < sb.idx
+			}
+		case sa.isIdx != sb.isIdx:
+			return sa.isIdx
+		case sa.key != sb.key:
+			return sa.key < sb.key
+		}
+	}
+
+	return len(a) < len(b)
+}
@@ -0,0 +1,246 @@
+package just_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/kazhuravlev/just"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPathDoc(t *testing.T) map[string]any {
+	t.Helper()
+
+	doc, err := just.JsonParseType[map[string]any]([]byte(`{
+		"name": "svc",
+		"db": {
+			"hosts": [
+				{"addr": "a", "port": 5432},
+				{"addr": "b", "port": 5433}
+			],
+			"opts": {}
+		},
+		"a/b": {"m~n": true},
+		"tags": ["x", "y", "z"]
+	}`))
+	require.NoError(t, err)
+
+	return *doc
+}
+
+func TestMapPathGet(t *testing.T) {
+	t.Parallel()
+
+	doc := newPathDoc(t)
+
+	table := []struct {
+		name  string
+		path  string
+		exp   any
+		expOk bool
+	}{
+		{name: "root", path: "", exp: doc, expOk: true},
+		{name: "key", path: "name", exp: "svc", expOk: true},
+		{name: "nested", path: "db.hosts[1].port", exp: 5433., expOk: true},
+		{name: "dotted_index", path: "db.hosts.1.port", exp: 5433., expOk: true},
+		{name: "index", path: "tags[2]", exp: "z", expOk: true},
+		{name: "pointer", path: "/db/hosts/0/addr", exp: "a", expOk: true},
+		{name: "pointer_escape", path: "/a~1b/m~0n", exp: true, expOk: true},
+		{name: "missing_key", path: "db.user", expOk: false},
+		{name: "index_out_of_range", path: "tags[3]", expOk: false},
+		{name: "index_of_map", path: "db[0]", expOk: false},
+		{name: "key_of_slice", path: "tags.first", expOk: false},
+		{name: "key_of_scalar", path: "name.first", expOk: false},
+		{name: "leading_zero", path: "tags.01", expOk: false},
+		{name: "invalid", path: "db..hosts", expOk: false},
+		{name: "invalid_index", path: "tags[a]", expOk: false},
+		{name: "invalid_escape", path: "/a~2b", expOk: false},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(row.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, ok := just.MapPathGet(doc, row.path)
+			assert.Equal(t, row.expOk, ok)
+			assert.Equal(t, row.exp, res)
+		})
+	}
+}
+
+func TestMapPathGetAs(t *testing.T) {
+	t.Parallel()
+
+	doc := newPathDoc(t)
+
+	port, ok := just.MapPathGetAs[float64](doc, "db.hosts[0].port")
+	assert.True(t, ok)
+	assert.Equal(t, 5432., port)
+
+	_, ok = just.MapPathGetAs[int](doc, "db.hosts[0].port")
+	assert.False(t, ok)
+
+	_, ok = just.MapPathGetAs[string](doc, "db.user")
+	assert.False(t, ok)
+
+	tags, ok := just.MapPathGetAs[[]any](doc, "tags")
+	assert.True(t, ok)
+	assert.Len(t, tags, 3)
+
+	assert.Equal(t, "svc", just.MapPathGetDefault(doc, "name", "default"))
+	assert.Equal(t, "default", just.MapPathGetDefault(doc, "db.user", "default"))
+}
+
+func TestMapPathSet(t *testing.T) {
+	t.Parallel()
+
+	doc := newPathDoc(t)
+
+	require.NoError(t, just.MapPathSet(doc, "db.hosts[1].port", 1.))
+	require.NoError(t, just.MapPathSet(doc, "db.user.name", "root"))
+	require.NoError(t, just.MapPathSet(doc, "tags[3]", "w"))
+	require.NoError(t, just.MapPathSet(doc, "/tags/-", "v"))
+	require.NoError(t, just.MapPathSet(doc, "list[0].id", 1.))
+	require.NoError(t, just.MapPathSet(doc, "/a~1b/m~0n", false))
+
+	assert.Equal(t, 1., just.MapPathGetDefault(doc, "db.hosts[1].port", 0.))
+	assert.Equal(t, "root", just.MapPathGetDefault(doc, "db.user.name", ""))
+	assert.Equal(t, []any{"x", "y", "z", "w", "v"}, doc["tags"])
+	assert.Equal(t, []any{map[string]any{"id": 1.}}, doc["list"])
+	assert.Equal(t, false, just.MapPathGetDefault(doc, "/a~1b/m~0n", true))
+
+	t.Run("errors", func(t *testing.T) {
+		assert.ErrorIs(t, just.MapPathSet(doc, "", 1), just.ErrInvalidPath)
+		assert.ErrorIs(t, just.MapPathSet(doc, "a..b", 1), just.ErrInvalidPath)
+		assert.ErrorIs(t, just.MapPathSet(doc, "name.first", 1), just.ErrPathNotContainer)
+		assert.ErrorIs(t, just.MapPathSet(doc, "db[0]", 1), just.ErrPathNotSlice)
+		assert.ErrorIs(t, just.MapPathSet(doc, "tags.first", 1), just.ErrPathNotMap)
+		assert.ErrorIs(t, just.MapPathSet(doc, "tags[9999999999]", 1), just.ErrPathIdxOutOfRange)
+		assert.ErrorIs(t, just.MapPathSet(doc, "/tags/6", 1), just.ErrPathIdxOutOfRange)
+		assert.ErrorIs(t, just.MapPathSet(doc, "missing[1]", 1), just.ErrPathIdxOutOfRange)
+		assert.NotContains(t, doc, "missing")
+	})
+}
+
+func TestMapPathDelete(t *testing.T) {
+	t.Parallel()
+
+	doc := newPathDoc(t)
+
+	assert.True(t, just.MapPathDelete(doc, "db.hosts[0]"))
+	assert.True(t, just.MapPathDelete(doc, "/tags/1"))
+	assert.True(t, just.MapPathDelete(doc, "name"))
+	assert.True(t, just.MapPathDelete(doc, "db.hosts[0].addr"))
+
+	assert.False(t, just.MapPathDelete(doc, "name"))
+	assert.False(t, just.MapPathDelete(doc, "tags[5]"))
+	assert.False(t, just.MapPathDelete(doc, "db[0]"))
+	assert.False(t, just.MapPathDelete(doc, ""))
+	assert.False(t, just.MapPathDelete(doc, "a..b"))
+
+	assert.Equal(t, []any{map[string]any{"port": 5433.}}, just.MapPathGetDefault[[]any](doc, "db.hosts", nil))
+	assert.Equal(t, []any{"x", "z"}, doc["tags"])
+	assert.False(t, just.MapContainsKey(doc, "name"))
+}
+
+func TestMapWalk(t *testing.T) {
+	t.Parallel()
+
+	doc := map[string]any{
+		"b": []any{1, map[string]any{"c": 2}},
+		"a": map[string]any{"x": 3},
+	}
+
+	var paths []string
+	err := just.MapWalk(doc, func(path string, val any) error {
+		paths = append(paths, path)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "a.x", "b", "b[0]", "b[1]", "b[1].c"}, paths)
+
+	t.Run("skip", func(t *testing.T) {
+		var paths []string
+		err := just.MapWalk(doc, func(path string, val any) error {
+			paths = append(paths, path)
+			if path == "b" {
+				return just.ErrMapWalkSkip
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "a.x", "b"}, paths)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		errStop := errors.New("stop")
+
+		var paths []string
+		err := just.MapWalk(doc, func(path string, val any) error {
+			paths = append(paths, path)
+			if path == "a.x" {
+				return errStop
+			}
+
+			return nil
+		})
+		assert.ErrorIs(t, err, errStop)
+		assert.Equal(t, []string{"a", "a.x"}, paths)
+	})
+}
+
+func TestMapFlatten(t *testing.T) {
+	t.Parallel()
+
+	doc := newPathDoc(t)
+	delete(doc, "a/b")
+
+	flat := just.MapFlatten(doc)
+	assert.Equal(t, map[string]any{
+		"name":             "svc",
+		"db.hosts[0].addr": "a",
+		"db.hosts[0].port": 5432.,
+		"db.hosts[1].addr": "b",
+		"db.hosts[1].port": 5433.,
+		"db.opts":          map[string]any{},
+		"tags[0]":          "x",
+		"tags[1]":          "y",
+		"tags[2]":          "z",
+	}, flat)
+
+	res, err := just.MapUnflatten(flat)
+	require.NoError(t, err)
+	assert.Equal(t, doc, res)
+
+	assert.Empty(t, just.MapFlatten(nil))
+}
+
+func TestMapUnflatten(t *testing.T) {
+	t.Parallel()
+
+	res, err := just.MapUnflatten(map[string]any{"a.b": 1, "a.c[1]": 2, "a.c[0]": 3})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": map[string]any{"b": 1, "c": []any{3, 2}}}, res)
+
+	flat := make(map[string]any)
+	for i := 0; i < 12; i++ {
+		flat["list["+strconv.Itoa(i)+"].id"] = i
+	}
+	res, err = just.MapUnflatten(flat)
+	require.NoError(t, err)
+	assert.Len(t, res["list"], 12)
+	assert.Equal(t, 10, just.MapPathGetDefault(res, "list[10].id", 0))
+
+	_, err = just.MapUnflatten(map[string]any{"a[1]": 1})
+	assert.ErrorIs(t, err, just.ErrPathIdxOutOfRange)
+
+	_, err = just.MapUnflatten(map[string]any{"a": 1, "a.b": 2})
+	assert.ErrorIs(t, err, just.ErrPathNotContainer)
+
+	_, err = just.MapUnflatten(map[string]any{"a..b": 1})
+	assert.ErrorIs(t, err, just.ErrInvalidPath)
+}
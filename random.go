@@ -0,0 +1,227 @@
+package just
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math"
+	"math/rand"
+)
+
+// RandSource is a source of random numbers. *rand.Rand from math/rand
+// implements this interface.
+type RandSource interface {
+	// Intn returns a random number in range [0, n). Panics when n <= 0.
+	Intn(n int) int
+	// Float64 returns a random number in range [0, 1).
+	Float64() float64
+}
+
+// randDefault uses top-level functions of math/rand.
+type randDefault struct{}
+
+func (randDefault) Intn(n int) int { return rand.Intn(n) }
+
+func (randDefault) Float64() float64 { return rand.Float64() }
+
+// RandDefault returns the source which uses top-level functions of
+// math/rand. It is safe for concurrent use.
+func RandDefault() RandSource {
+	return randDefault{}
+}
+
+// RandSeeded returns the source which produces the same sequence of numbers
+// for the same `seed`. Useful for reproducible tests. It is not safe for
+// concurrent use.
+func RandSeeded(seed int64) RandSource {
+	return rand.New(rand.NewSource(seed))
+}
+
+// randCrypto uses crypto/rand.
+type randCrypto struct{}
+
+func (randCrypto) uint64() uint64 {
+	var buf [8]byte
+	if _, err := crand.Read(buf[:]); err != nil {
+		panic("crypto/rand: " + err.Error())
+	}
+
+	return binary.LittleEndian.Uint64(buf[:])
+}
+
+func (r randCrypto) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+
+	// Reject values from the incomplete last range to avoid modulo bias.
+	bound := uint64(n)
+	limit := math.MaxUint64 - math.MaxUint64%bound
+	for {
+		v := r.uint64()
+		if v < limit {
+			return int(v % bound)
+		}
+	}
+}
+
+func (r randCrypto) Float64() float64 {
+	return float64(r.uint64()>>11) / (1 << 53)
+}
+
+// RandCrypto returns the source which uses crypto/rand. It is suitable for
+// security-sensitive cases and is safe for concurrent use.
+func RandCrypto() RandSource {
+	return randCrypto{}
+}
+
+// SliceShuffleRand will shuffle the slice in-place using `src`.
+func SliceShuffleRand[T any](in []T, src RandSource) {
+	for i := range in {
+		j := src.Intn(i + 1)
+		in[i], in[j] = in[j], in[i]
+	}
+}
+
+// SliceShuffleCopyRand will make a copy and shuffle slice using `src`.
+func SliceShuffleCopyRand[T any](in []T, src RandSource) []T {
+	res := SliceCopy(in)
+	SliceShuffleRand(res, src)
+
+	return res
+}
+
+// SliceChoice returns a random element of `in`. Idx is -1 when `in` is
+// empty.
+func SliceChoice[T any](in []T, src RandSource) SliceElem[T] {
+	if len(in) == 0 {
+		return SliceElem[T]{Idx: -1}
+	}
+
+	i := src.Intn(len(in))
+
+	return SliceElem[T]{Idx: i, Val: in[i]}
+}
+
+// SliceChoiceWeighted returns a random element of `in`, where the
+// probability of each element is proportional to its `weight`. Elements
+// with not positive weight are never chosen. Idx is -1 when there are no
+// elements with positive weight.
+func SliceChoiceWeighted[T any](in []T, weight func(T) float64, src RandSource) SliceElem[T] {
+	weights := make([]float64, len(in))
+	var total float64
+	for i := range in {
+		if w := weight(in[i]); w > 0 {
+			weights[i] = w
+			total += w
+		}
+	}
+
+	if total == 0 {
+		return SliceElem[T]{Idx: -1}
+	}
+
+	last := -1
+	x := src.Float64() * total
+	for i, w := range weights {
+		if w == 0 {
+			continue
+		}
+
+		last = i
+		if x < w {
+			break
+		}
+
+		x -= w
+	}
+
+	return SliceElem[T]{Idx: last, Val: in[last]}
+}
+
+// SliceSample returns `k` random elements of `in` without replacement, in
+// random order. Returns all elements when `k` is greater than len of `in`.
+// The source slice is not modified.
+func SliceSample[T any](in []T, k int, src RandSource) []T {
+	k = Max(Min(k, len(in)), 0)
+
+	res := SliceCopy(in)
+	// Partial Fisher-Yates shuffle: the first k elements are the sample.
+	for i := 0; i < k; i++ {
+		j := i + src.Intn(len(res)-i)
+		res[i], res[j] = res[j], res[i]
+	}
+
+	return res[:k:k]
+}
+
+// SliceSampleWeighted returns `k` random elements of `in` without
+// replacement, where elements with a greater `weight` are more likely to be
+// chosen and to be closer to the start of the result. Elements with not
+// positive weight are never chosen, so the result can be shorter than `k`.
+// The source slice is not modified.
+func SliceSampleWeighted[T any](in []T, k int, weight func(T) float64, src RandSource) []T {
+	// Efraimidis-Spirakis algorithm: take elements with the greatest
+	// u^(1/w) keys, where u is uniform in (0, 1]. Logarithms are compared
+	// to keep precision for small weights.
+	keyed := make([]Pair[float64, T], 0, len(in))
+	for i := range in {
+		w := weight(in[i])
+		if !(w > 0) {
+			continue
+		}
+
+		u := 1 - src.Float64()
+		keyed = append(keyed, Pair[float64, T]{First: math.Log(u) / w, Second: in[i]})
+	}
+
+	top := SliceTopK(keyed, k, LessBy(func(p Pair[float64, T]) float64 { return p.First }))
+
+	return SliceMap(top, func(p Pair[float64, T]) T { return p.Second })
+}
+
+// Reservoir keeps a uniform random sample of `k` elements from the stream of
+// unknown len. Reservoir is not thread-safe.
+type Reservoir[T any] struct {
+	items []T
+	k     int
+	count int
+	src   RandSource
+}
+
+// NewReservoir returns the reservoir which keeps `k` random elements of all
+// elements added to it.
+func NewReservoir[T any](k int, src RandSource) *Reservoir[T] {
+	if k < 1 {
+		panic("k should be >= 1")
+	}
+
+	return &Reservoir[T]{
+		items: make([]T, 0, k),
+		k:     k,
+		src:   src,
+	}
+}
+
+// Add adds the element of the stream to the reservoir.
+func (r *Reservoir[T]) Add(v T) {
+	r.count++
+	if len(r.items) < r.k {
+		r.items = append(r.items, v)
+		return
+	}
+
+	if j := r.src.Intn(r.count); j < r.k {
+		r.items[j] = v
+	}
+}
+
+// Count returns the number of elements which were added to the reservoir.
+func (r *Reservoir[T]) Count() int {
+	return r.count
+}
+
+// Values returns the sample. It contains all added elements when there were
+// less than `k` of them.
+func (r *Reservoir[T]) Values() []T {
+	return SliceCopy(r.items)
+}
@@ -0,0 +1,213 @@
+package just_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/kazhuravlev/just"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandSources(t *testing.T) {
+	t.Parallel()
+
+	sources := map[string]just.RandSource{
+		"default": just.RandDefault(),
+		"seeded":  just.RandSeeded(1),
+		"crypto":  just.RandCrypto(),
+		"rand":    rand.New(rand.NewSource(1)),
+	}
+
+	for name, src := range sources {
+		src := src
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			counts := make([]int, 5)
+			for i := 0; i < 5000; i++ {
+				v := src.Intn(5)
+				require.True(t, v >= 0 && v < 5)
+				counts[v]++
+
+				f := src.Float64()
+				require.True(t, f >= 0 && f < 1)
+			}
+
+			for _, c := range counts {
+				assert.InDelta(t, 1000, c, 150)
+			}
+
+			assert.Panics(t, func() { src.Intn(0) })
+		})
+	}
+}
+
+func TestRandSeeded(t *testing.T) {
+	t.Parallel()
+
+	in := just.SliceRange(0, 20, 1)
+	res1 := just.SliceShuffleCopyRand(in, just.RandSeeded(42))
+	res2 := just.SliceShuffleCopyRand(in, just.RandSeeded(42))
+	res3 := just.SliceShuffleCopyRand(in, just.RandSeeded(43))
+	assert.Equal(t, res1, res2)
+	assert.NotEqual(t, res1, res3)
+	assert.ElementsMatch(t, in, res1)
+	assert.Equal(t, just.SliceRange(0, 20, 1), in)
+
+	src := just.RandSeeded(42)
+	sample1 := just.SliceSample(in, 5, src)
+	choice1 := just.SliceChoice(in, src)
+
+	src = just.RandSeeded(42)
+	assert.Equal(t, sample1, just.SliceSample(in, 5, src))
+	assert.Equal(t, choice1, just.SliceChoice(in, src))
+}
+
+func TestSliceShuffleRand(t *testing.T) {
+	t.Parallel()
+
+	var empty []int
+	just.SliceShuffleRand(empty, just.RandSeeded(1))
+	assert.Nil(t, empty)
+
+	in := []int{1, 2, 3, 4, 5}
+	just.SliceShuffleRand(in, just.RandCrypto())
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, in)
+
+	// Each of 6 permutations of 3 elements should be equally likely.
+	src := just.RandSeeded(1)
+	counts := make(map[[3]int]int)
+	for i := 0; i < 6000; i++ {
+		in := []int{1, 2, 3}
+		just.SliceShuffleRand(in, src)
+		counts[[3]int{in[0], in[1], in[2]}]++
+	}
+
+	assert.Len(t, counts, 6)
+	for _, c := range counts {
+		assert.InDelta(t, 1000, c, 150)
+	}
+}
+
+func TestSliceChoice(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, just.SliceElem[int]{Idx: -1}, just.SliceChoice([]int{}, just.RandSeeded(1)))
+
+	in := []string{"a", "b", "c"}
+	for i := 0; i < 100; i++ {
+		e := just.SliceChoice(in, just.RandDefault())
+		require.True(t, e.Ok())
+		require.Equal(t, in[e.Idx], e.Val)
+	}
+}
+
+func TestSliceChoiceWeighted(t *testing.T) {
+	t.Parallel()
+
+	weight := func(v int) float64 { return float64(v) }
+
+	assert.Equal(t, -1, just.SliceChoiceWeighted([]int{}, weight, just.RandSeeded(1)).Idx)
+	assert.Equal(t, -1, just.SliceChoiceWeighted([]int{0, -1}, weight, just.RandSeeded(1)).Idx)
+
+	src := just.RandSeeded(1)
+	counts := make(map[int]int)
+	for i := 0; i < 6000; i++ {
+		e := just.SliceChoiceWeighted([]int{1, 0, 2, -5, 3}, weight, src)
+		require.Equal(t, []int{1, 0, 2, -5, 3}[e.Idx], e.Val)
+		counts[e.Val]++
+	}
+
+	assert.Len(t, counts, 3)
+	assert.InDelta(t, 1000, counts[1], 150)
+	assert.InDelta(t, 2000, counts[2], 150)
+	assert.InDelta(t, 3000, counts[3], 150)
+}
+
+func TestSliceSample(t *testing.T) {
+	t.Parallel()
+
+	in := just.SliceRange(0, 10, 1)
+	src := just.RandSeeded(1)
+
+	assert.Equal(t, []int{}, just.SliceSample(in, 0, src))
+	assert.Equal(t, []int{}, just.SliceSample(in, -1, src))
+	assert.Equal(t, []int{}, just.SliceSample([]int(nil), 3, src))
+	assert.ElementsMatch(t, in, just.SliceSample(in, 20, src))
+
+	counts := make(map[int]int)
+	for i := 0; i < 5000; i++ {
+		res := just.SliceSample(in, 3, src)
+		require.Len(t, res, 3)
+		require.Len(t, just.SliceUniq(res), 3)
+		for _, v := range res {
+			counts[v]++
+		}
+	}
+
+	for _, v := range in {
+		assert.InDelta(t, 1500, counts[v], 200)
+	}
+	assert.Equal(t, just.SliceRange(0, 10, 1), in)
+}
+
+func TestSliceSampleWeighted(t *testing.T) {
+	t.Parallel()
+
+	weight := func(v int) float64 { return float64(v) }
+	src := just.RandSeeded(1)
+
+	assert.Equal(t, []int{}, just.SliceSampleWeighted([]int{1, 2}, 0, weight, src))
+	assert.ElementsMatch(t, []int{1, 2}, just.SliceSampleWeighted([]int{0, 1, 2, -1}, 5, weight, src))
+
+	counts := make(map[int]int)
+	for i := 0; i < 5000; i++ {
+		res := just.SliceSampleWeighted([]int{1, 2, 100}, 1, weight, src)
+		require.Len(t, res, 1)
+		counts[res[0]]++
+	}
+
+	assert.Greater(t, counts[100], 4500)
+	assert.Greater(t, counts[2], counts[1])
+
+	for i := 0; i < 100; i++ {
+		res := just.SliceSampleWeighted([]int{1, 2, 3, 4}, 2, weight, src)
+		require.Len(t, just.SliceUniq(res), 2)
+	}
+}
+
+func TestReservoir(t *testing.T) {
+	t.Parallel()
+
+	r := just.NewReservoir[int](3, just.RandSeeded(1))
+	assert.Equal(t, []int{}, r.Values())
+
+	r.Add(1)
+	r.Add(2)
+	assert.Equal(t, []int{1, 2}, r.Values())
+	assert.Equal(t, 2, r.Count())
+
+	src := just.RandSeeded(1)
+	counts := make(map[int]int)
+	for i := 0; i < 3000; i++ {
+		r := just.NewReservoir[int](2, src)
+		for v := 0; v < 10; v++ {
+			r.Add(v)
+		}
+
+		res := r.Values()
+		require.Len(t, res, 2)
+		require.Len(t, just.SliceUniq(res), 2)
+		require.Equal(t, 10, r.Count())
+		for _, v := range res {
+			counts[v]++
+		}
+	}
+
+	for v := 0; v < 10; v++ {
+		assert.InDelta(t, 600, counts[v], 100)
+	}
+
+	assert.Panics(t, func() { just.NewReservoir[int](0, src) })
+}
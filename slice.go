@@ -1,8 +1,14 @@
 package just
 
 import (
-	"math/rand"
+	"container/heap"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
 	"sort"
+
+	"golang.org/x/exp/constraints"
 )
 
 // SliceUniq returns unique values from `in`.
@@ -482,40 +488,120 @@ func SliceFindAllIndexes[T any](in []T, fn func(i int, elem T) bool) []int {
 }
 
 // SliceRange produces a sequence of integers from start (inclusive)
-// to stop (exclusive) by step.
+// to stop (exclusive) by step. Each element is computed as
+// `start + i*step`, so float steps do not accumulate an error.
 func SliceRange[T number](start, stop, step T) []T {
-	if start == stop {
-		return make([]T, 0)
+	res := make([]T, 0, SliceRangeCount(start, stop, step))
+	SliceRangeIter(start, stop, step)(func(_ int, elem T) bool {
+		res = append(res, elem)
+		return true
+	})
+
+	return res
+}
+
+// SliceRangeIter does the same as SliceRange but returns an iterator, which
+// produces elements lazily. Check this docs https://go.dev/ref/spec#For_range.
+func SliceRangeIter[T number](start, stop, step T) func(func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		n := SliceRangeCount(start, stop, step)
+		isFloat := T(1)/T(2) != 0
+
+		e := start
+		for i := 0; i < n; i++ {
+			if isFloat {
+				e = start + T(i)*step
+			}
+
+			if !yield(i, e) {
+				return
+			}
+
+			if !isFloat {
+				e += step
+			}
+		}
 	}
+}
 
-	if step == 0 {
-		return make([]T, 0)
+// SliceRangeCount returns the count of elements, which will be produced by
+// SliceRange with the same arguments.
+func SliceRangeCount[T number](start, stop, step T) int {
+	if start == stop || step == 0 {
+		return 0
 	}
 
 	isIncr := start < stop
-	if isIncr && step < 0 {
-		return make([]T, 0)
+	if isIncr && step < 0 || !isIncr && step > 0 {
+		return 0
 	}
 
-	if !isIncr && step > 0 {
-		return make([]T, 0)
+	if T(1)/T(2) != 0 {
+		return floatRangeCount(start, stop, step)
 	}
 
-	res := make([]T, 0, int(Abs((start-stop)/step)))
-	e := start
-	for {
-		if isIncr && e >= stop {
-			break
-		}
+	// Integers are converted to uint64 to avoid overflow. Conversion of
+	// negative numbers keeps the distance between numbers modulo 2^64.
+	var dist, absStep uint64
+	if isIncr {
+		dist, absStep = uint64(stop)-uint64(start), uint64(step)
+	} else {
+		dist, absStep = uint64(start)-uint64(stop), -uint64(step)
+	}
+
+	return int((dist-1)/absStep + 1)
+}
+
+func floatRangeCount[T number](start, stop, step T) int {
+	n := int(math.Ceil(float64((stop - start) / step)))
+	if n < 0 {
+		return 0
+	}
 
-		if !isIncr && e <= stop {
-			break
+	// Fix the rounding error of division to make the count consistent with
+	// elements `start + i*step`.
+	isBefore := func(i int) bool {
+		e := start + T(i)*step
+		if step > 0 {
+			return e < stop
 		}
 
-		res = append(res, e)
-		e += step
+		return e > stop
+	}
+
+	for n > 0 && !isBefore(n-1) {
+		n--
+	}
+
+	for isBefore(n) {
+		n++
+	}
+
+	return n
+}
+
+// SliceLinspace returns `num` evenly spaced numbers from `start` to `stop`
+// inclusive. Returns an empty slice when `num` <= 0 and `[start]` when
+// `num` == 1.
+// Example: 0, 1, 5 => [0, 0.25, 0.5, 0.75, 1]
+func SliceLinspace[T constraints.Float](start, stop T, num int) []T {
+	if num <= 0 {
+		return make([]T, 0)
+	}
+
+	res := make([]T, num)
+	if num == 1 {
+		res[0] = start
+		return res
+	}
+
+	step := (stop - start) / T(num-1)
+	for i := range res {
+		res[i] = start + T(i)*step
 	}
 
+	res[num-1] = stop
+
 	return res
 }
 
@@ -572,6 +658,14 @@ func SliceSort[T any](in []T, less func(a, b T) bool) {
 	})
 }
 
+// SliceSortUnstable sort slice inplace. It is faster than SliceSort, but
+// does not keep the original order of equal elements.
+func SliceSortUnstable[T any](in []T, less func(a, b T) bool) {
+	sort.Slice(in, func(i, j int) bool {
+		return less(in[i], in[j])
+	})
+}
+
 // SliceSortCopy copy and sort slice.
 func SliceSortCopy[T any](in []T, less func(a, b T) bool) []T {
 	res := make([]T, len(in))
@@ -598,6 +692,160 @@ func SliceGroupBy[K comparable, V any](in []V, fn func(V) K) map[K][]V {
 	return res
 }
 
+// SliceGroupByOrdered will group all elements by key like SliceGroupBy, but
+// returns groups in order of the first occurrence of their keys.
+// Example: [1,2,3,4], odd/even => [(odd,[1,3]), (even,[2,4])]
+func SliceGroupByOrdered[K comparable, V any](in []V, fn func(V) K) []KV[K, []V] {
+	res := make([]KV[K, []V], 0)
+	index := make(map[K]int)
+	for i := range in {
+		key := fn(in[i])
+		idx, ok := index[key]
+		if !ok {
+			idx = len(res)
+			index[key] = idx
+			res = append(res, KV[K, []V]{Key: key})
+		}
+
+		res[idx].Val = append(res[idx].Val, in[i])
+	}
+
+	return res
+}
+
+// SliceGroupByFold will group all elements by key and fold elements of each
+// group into the single value, starting from `init`.
+// Example: ["a","bb","cc"], len, 0, sum of len => {1:1, 2:4}
+func SliceGroupByFold[K comparable, V, A any](in []V, fn func(V) K, init A, reduce func(acc A, elem V) A) map[K]A {
+	res := make(map[K]A)
+	for i := range in {
+		key := fn(in[i])
+		acc, ok := res[key]
+		if !ok {
+			acc = init
+		}
+
+		res[key] = reduce(acc, in[i])
+	}
+
+	return res
+}
+
+// SliceGroupByCount returns the number of elements for each key.
+func SliceGroupByCount[K comparable, V any](in []V, fn func(V) K) map[K]int {
+	return SliceGroupByFold(in, fn, 0, func(acc int, _ V) int { return acc + 1 })
+}
+
+// SliceGroupBySum returns the sum of `val` of elements for each key.
+func SliceGroupBySum[K comparable, V any, N number](in []V, fn func(V) K, val func(V) N) map[K]N {
+	return SliceGroupByFold(in, fn, N(0), func(acc N, elem V) N { return acc + val(elem) })
+}
+
+// SliceGroupByMin returns the least element according to `less` for each
+// key. The first one is returned when there are several least elements.
+func SliceGroupByMin[K comparable, V any](in []V, fn func(V) K, less func(a, b V) bool) map[K]V {
+	res := make(map[K]V)
+	for i := range in {
+		key := fn(in[i])
+		if cur, ok := res[key]; !ok || less(in[i], cur) {
+			res[key] = in[i]
+		}
+	}
+
+	return res
+}
+
+// SliceGroupByMax returns the greatest element according to `less` for each
+// key. The first one is returned when there are several greatest elements.
+func SliceGroupByMax[K comparable, V any](in []V, fn func(V) K, less func(a, b V) bool) map[K]V {
+	return SliceGroupByMin(in, fn, LessReverse(less))
+}
+
+// SliceGroupByFirst returns the first element for each key.
+func SliceGroupByFirst[K comparable, V any](in []V, fn func(V) K) map[K]V {
+	res := make(map[K]V)
+	for i := range in {
+		key := fn(in[i])
+		if _, ok := res[key]; !ok {
+			res[key] = in[i]
+		}
+	}
+
+	return res
+}
+
+// SliceGroupByLast returns the last element for each key.
+func SliceGroupByLast[K comparable, V any](in []V, fn func(V) K) map[K]V {
+	res := make(map[K]V)
+	for i := range in {
+		res[fn(in[i])] = in[i]
+	}
+
+	return res
+}
+
+// SlicePartition returns elements such that fn(elem) == true and all other
+// elements. Both results keep the original order.
+// Example: [1,2,3,4], odd => [1,3], [2,4]
+func SlicePartition[T any](in []T, fn func(T) bool) ([]T, []T) {
+	matched := make([]T, 0)
+	unmatched := make([]T, 0)
+	for i := range in {
+		if fn(in[i]) {
+			matched = append(matched, in[i])
+		} else {
+			unmatched = append(unmatched, in[i])
+		}
+	}
+
+	return matched, unmatched
+}
+
+// ErrDuplicateKey returned when keys should be unique but they are not.
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// SliceIndexBy returns the map where each element is stored by its key.
+// Returns ErrDuplicateKey when two elements have the same key.
+func SliceIndexBy[K comparable, V any](in []V, fn func(V) K) (map[K]V, error) {
+	res := make(map[K]V, len(in))
+	index := make(map[K]int, len(in))
+	for i := range in {
+		key := fn(in[i])
+		if j, ok := index[key]; ok {
+			return nil, fmt.Errorf("%w: %v at %d and %d", ErrDuplicateKey, key, j, i)
+		}
+
+		index[key] = i
+		res[key] = in[i]
+	}
+
+	return res, nil
+}
+
+// SliceReduce reduces elements of `in` to the single value by applying `fn`
+// to the accumulator and each next element, starting from the first
+// element. Returns invalid value when `in` is empty.
+// Example: [1,2,3], sum => 6
+func SliceReduce[T any](in []T, fn func(acc, elem T) T) NullVal[T] {
+	if len(in) == 0 {
+		return NullNull[T]()
+	}
+
+	return Null(SliceFold(in[1:], in[0], fn))
+}
+
+// SliceFold folds elements of `in` to the single value by applying `fn` to
+// the accumulator and each next element, starting from `init`.
+// Example: ["a","bb"], 0, sum of len => 3
+func SliceFold[T, A any](in []T, init A, fn func(acc A, elem T) A) A {
+	acc := init
+	for i := range in {
+		acc = fn(acc, in[i])
+	}
+
+	return acc
+}
+
 // Slice2MapFn apply fn to every elem. fn should return key and value, which
 // will be applied to result map.
 func Slice2MapFn[T any, K comparable, V any](in []T, fn func(idx int, elem T) (K, V)) map[K]V {
@@ -772,22 +1020,16 @@ func SliceIter[T any](in []T) func(func(IterContext, T) bool) {
 	}
 }
 
-// SliceShuffle will shuffle the slice in-place.
+// SliceShuffle will shuffle the slice in-place. See SliceShuffleRand to
+// choose the source of randomness.
 func SliceShuffle[T any](in []T) {
-	for i := range in {
-		j := rand.Intn(i + 1)
-		in[i], in[j] = in[j], in[i]
-	}
+	SliceShuffleRand(in, RandDefault())
 }
 
-// SliceShuffleCopy will make a copy and shuffle slice.
+// SliceShuffleCopy will make a copy and shuffle slice. See
+// SliceShuffleCopyRand to choose the source of randomness.
 func SliceShuffleCopy[T any](in []T) []T {
-	res := make([]T, len(in))
-	copy(res, in)
-
-	SliceShuffle(res)
-
-	return res
+	return SliceShuffleCopyRand(in, RandDefault())
 }
 
 // SliceLastN return up to last n elements from input slice in original order.
@@ -817,3 +1059,792 @@ func SliceLastN[T any](in []T, n int) []T {
 
 	return res
 }
+
+// SliceNullValues returns values of all valid elements from `in`.
+// Example: [Null(1), NullNull(), Null(3)] => [1, 3]
+func SliceNullValues[T any](in []NullVal[T]) []T {
+	valid := SliceFilter(in, func(v NullVal[T]) bool { return v.Valid })
+
+	return SliceMap(valid, func(v NullVal[T]) T { return v.Val })
+}
+
+// SliceNullDefault returns values of all elements from `in`, where invalid
+// elements are replaced by `defaultVal`.
+// Example: [Null(1), NullNull(), Null(3)], 0 => [1, 0, 3]
+func SliceNullDefault[T any](in []NullVal[T], defaultVal T) []T {
+	return SliceMap(in, func(v NullVal[T]) T { return v.OrElse(defaultVal) })
+}
+
+// SliceNullAllValid returns true when all elements from `in` are valid.
+// Returns true when in is empty.
+func SliceNullAllValid[T any](in []NullVal[T]) bool {
+	return SliceAll(in, func(v NullVal[T]) bool { return v.Valid })
+}
+
+// SliceNullAnyValid returns true when at least one element from `in` is
+// valid.
+func SliceNullAnyValid[T any](in []NullVal[T]) bool {
+	return SliceAny(in, func(v NullVal[T]) bool { return v.Valid })
+}
+
+// SlicePointers2Null returns the slice of NullVal, where nil pointers are
+// converted to invalid elements.
+func SlicePointers2Null[T any](in []*T) []NullVal[T] {
+	return SliceMap(in, NullFromPointer[T])
+}
+
+// SliceNull2Pointers returns the slice of pointers, where invalid elements
+// are converted to nil pointers.
+func SliceNull2Pointers[T any](in []NullVal[T]) []*T {
+	return SliceMap(in, func(v NullVal[T]) *T { return v.Pointer() })
+}
+
+// SliceIsSorted returns true when `in` is sorted according to `less`.
+func SliceIsSorted[T any](in []T, less func(a, b T) bool) bool {
+	for i := 1; i < len(in); i++ {
+		if less(in[i], in[i-1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SliceLowerBound returns the index of the first element of sorted `in`
+// which is not less than `elem`. Returns len(in) when all elements are less
+// than `elem`.
+func SliceLowerBound[T any](in []T, elem T, less func(a, b T) bool) int {
+	return sort.Search(len(in), func(i int) bool {
+		return !less(in[i], elem)
+	})
+}
+
+// SliceUpperBound returns the index of the first element of sorted `in`
+// which is greater than `elem`. Returns len(in) when there are no such
+// elements.
+func SliceUpperBound[T any](in []T, elem T, less func(a, b T) bool) int {
+	return sort.Search(len(in), func(i int) bool {
+		return less(elem, in[i])
+	})
+}
+
+// SliceBinarySearchFn returns the first element of sorted `in` which is
+// equal to `elem` according to `less`. Returns SliceElem with Idx == -1 when
+// the element is not found.
+func SliceBinarySearchFn[T any](in []T, elem T, less func(a, b T) bool) SliceElem[T] {
+	i := SliceLowerBound(in, elem, less)
+	if i == len(in) || less(elem, in[i]) {
+		return SliceElem[T]{
+			Idx: -1,
+		}
+	}
+
+	return SliceElem[T]{
+		Idx: i,
+		Val: in[i],
+	}
+}
+
+// SliceBinarySearch returns the first element of sorted `in` which is equal
+// to `elem`. Returns SliceElem with Idx == -1 when the element is not found.
+func SliceBinarySearch[T constraints.Ordered](in []T, elem T) SliceElem[T] {
+	return SliceBinarySearchFn(in, elem, func(a, b T) bool { return a < b })
+}
+
+// SliceInsertSorted inserts `elem` into sorted `in` and keeps it sorted. The
+// element is inserted after all equal elements. May modify the source slice
+// like append.
+func SliceInsertSorted[T any](in []T, elem T, less func(a, b T) bool) []T {
+	i := SliceUpperBound(in, elem, less)
+
+	var zero T
+	in = append(in, zero)
+	copy(in[i+1:], in[i:])
+	in[i] = elem
+
+	return in
+}
+
+// SliceUniqSorted returns the first element of each group of equal elements
+// from sorted `in`. Elements are equal when neither is less than the other.
+// Example: [1,1,2,3,3] => [1,2,3]
+func SliceUniqSorted[T any](in []T, less func(a, b T) bool) []T {
+	if len(in) == 0 {
+		return make([]T, 0)
+	}
+
+	res := make([]T, 1, len(in))
+	res[0] = in[0]
+	for i := 1; i < len(in); i++ {
+		if !less(res[len(res)-1], in[i]) {
+			continue
+		}
+
+		res = append(res, in[i])
+	}
+
+	return res
+}
+
+// SliceMergeSorted merges sorted slices `in` into one sorted slice. Equal
+// elements keep the order of the input slices.
+// Example: [1,4], [2,3,5] => [1,2,3,4,5]
+func SliceMergeSorted[T any](less func(a, b T) bool, in ...[]T) []T {
+	var total int
+	h := &sliceHeap[mergeCursor]{
+		less: func(a, b mergeCursor) bool {
+			va, vb := in[a.slice][a.idx], in[b.slice][b.idx]
+			if less(va, vb) {
+				return true
+			}
+
+			if less(vb, va) {
+				return false
+			}
+
+			return a.slice < b.slice
+		},
+	}
+	for i := range in {
+		total += len(in[i])
+		if len(in[i]) != 0 {
+			h.items = append(h.items, mergeCursor{slice: i})
+		}
+	}
+
+	heap.Init(h)
+
+	res := make([]T, 0, total)
+	for h.Len() != 0 {
+		cur := &h.items[0]
+		res = append(res, in[cur.slice][cur.idx])
+		cur.idx++
+		if cur.idx == len(in[cur.slice]) {
+			heap.Pop(h)
+			continue
+		}
+
+		heap.Fix(h, 0)
+	}
+
+	return res
+}
+
+// mergeCursor is a position in one of sorted slices.
+type mergeCursor struct {
+	slice int
+	idx   int
+}
+
+// SlicePage represents one page of the slice.
+type SlicePage[T any] struct {
+	// Items contains elements of the page.
+	Items []T
+	// Page is a 1-based page number.
+	Page int
+	// Size is a max number of elements on the page.
+	Size int
+	// TotalItems is the len of the source slice.
+	TotalItems int
+	// TotalPages is the number of non-empty pages.
+	TotalPages int
+}
+
+// HasNext returns true when there is a page after this one.
+func (p SlicePage[T]) HasNext() bool {
+	return p.Page < p.TotalPages
+}
+
+// HasPrev returns true when there is a page before this one.
+func (p SlicePage[T]) HasPrev() bool {
+	return p.Page > 1 && p.TotalPages > 0
+}
+
+// SlicePaginate returns the page number `page` (1-based) of size `size` from
+// `in`. Items of the page beyond the last one are empty.
+func SlicePaginate[T any](in []T, page, size int) SlicePage[T] {
+	if page < 1 {
+		panic("page should be >= 1")
+	}
+
+	if size < 1 {
+		panic("size should be >= 1")
+	}
+
+	offset := (page - 1) * size
+	if page-1 > len(in)/size {
+		offset = len(in)
+	}
+
+	return SlicePage[T]{
+		Items:      SliceOffsetLimit(in, offset, size),
+		Page:       page,
+		Size:       size,
+		TotalItems: len(in),
+		TotalPages: (len(in) + size - 1) / size,
+	}
+}
+
+// SliceOffsetLimit returns a subslice of source slice, which skips first
+// `offset` elements and contains not more than `limit` elements.
+func SliceOffsetLimit[T any](in []T, offset, limit int) []T {
+	if offset < 0 {
+		panic("offset should be >= 0")
+	}
+
+	if offset >= len(in) {
+		return make([]T, 0)
+	}
+
+	return SliceGetFirstN(in[offset:], limit)
+}
+
+// SliceCursorPage returns up to `limit` elements from `in` which keys are
+// greater than `after`, and the key of the last returned element as the
+// cursor for the next page. `in` should be sorted by `key` in ascending
+// order and keys should be unique. All elements are considered when `after`
+// is invalid. The next cursor is invalid when there are no more elements.
+// Returns ErrDuplicateKey when the page ends between elements with the same
+// key, because the next page would skip them.
+func SliceCursorPage[T any, K constraints.Ordered](in []T, key func(T) K, after NullVal[K], limit int) ([]T, NullVal[K], error) {
+	if limit < 1 {
+		panic("limit should be >= 1")
+	}
+
+	var start int
+	if after.Valid {
+		start = sort.Search(len(in), func(i int) bool {
+			return key(in[i]) > after.Val
+		})
+	}
+
+	res := SliceOffsetLimit(in, start, limit)
+	end := start + len(res)
+	if len(res) == 0 || end == len(in) {
+		return res, NullNull[K](), nil
+	}
+
+	last := key(res[len(res)-1])
+	if key(in[end]) == last {
+		return nil, NullNull[K](), fmt.Errorf("%w: %v at %d and %d", ErrDuplicateKey, last, end-1, end)
+	}
+
+	return res, Null(last), nil
+}
+
+// SliceWindows returns all windows of `size` elements, where each next
+// window starts `step` elements after the previous one. Only full windows
+// are returned. Windows share memory with `in`.
+// Example: [1,2,3,4,5], 3, 1 => [[1,2,3], [2,3,4], [3,4,5]]
+func SliceWindows[T any](in []T, size, step int) [][]T {
+	if size < 1 {
+		panic("size should be >= 1")
+	}
+
+	if step < 1 {
+		panic("step should be >= 1")
+	}
+
+	if len(in) < size {
+		return make([][]T, 0)
+	}
+
+	res := make([][]T, 0, (len(in)-size)/step+1)
+	for i := 0; i+size <= len(in); i += step {
+		res = append(res, in[i:i+size:i+size])
+	}
+
+	return res
+}
+
+// SlicePairwise create an iterator over adjacent pairs of elements from
+// slice. Check this docs https://go.dev/ref/spec#For_range.
+// Example: [1,2,3] => (1,2), (2,3)
+func SlicePairwise[T any](in []T) func(func(T, T) bool) {
+	return func(yield func(T, T) bool) {
+		for i := 1; i < len(in); i++ {
+			if !yield(in[i-1], in[i]) {
+				return
+			}
+		}
+	}
+}
+
+// ErrLengthMismatch returned when slices should have the same len but they
+// don't.
+var ErrLengthMismatch = errors.New("slices have different len")
+
+// SliceZip2 returns pairs of elements from `a` and `b` at the corresponding
+// positions. The result has the len of the shorter slice.
+// Example: [1,2,3], ["a","b"] => [(1,"a"), (2,"b")]
+func SliceZip2[A, B any](a []A, b []B) []Pair[A, B] {
+	n := Min(len(a), len(b))
+	res := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		res[i] = NewPair(a[i], b[i])
+	}
+
+	return res
+}
+
+// SliceZip2Pad does the same as SliceZip2 but the result has the len of the
+// longer slice. Missing elements are replaced by `defA` and `defB`.
+func SliceZip2Pad[A, B any](a []A, b []B, defA A, defB B) []Pair[A, B] {
+	n := Max(len(a), len(b))
+	res := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		res[i] = NewPair(sliceGetDefault(a, i, defA), sliceGetDefault(b, i, defB))
+	}
+
+	return res
+}
+
+// SliceZip2Strict does the same as SliceZip2 but returns ErrLengthMismatch
+// when slices have different len.
+func SliceZip2Strict[A, B any](a []A, b []B) ([]Pair[A, B], error) {
+	if len(a) != len(b) {
+		return nil, fmt.Errorf("zip %d and %d elements: %w", len(a), len(b), ErrLengthMismatch)
+	}
+
+	return SliceZip2(a, b), nil
+}
+
+// SliceUnzip2 splits pairs into two slices. That is the reverse of
+// SliceZip2.
+func SliceUnzip2[A, B any](in []Pair[A, B]) ([]A, []B) {
+	resA := make([]A, len(in))
+	resB := make([]B, len(in))
+	for i := range in {
+		resA[i], resB[i] = in[i].Values()
+	}
+
+	return resA, resB
+}
+
+// SliceZip3 returns triples of elements from `a`, `b` and `c` at the
+// corresponding positions. The result has the len of the shortest slice.
+func SliceZip3[A, B, C any](a []A, b []B, c []C) []Triple[A, B, C] {
+	n := Min(len(a), len(b), len(c))
+	res := make([]Triple[A, B, C], n)
+	for i := 0; i < n; i++ {
+		res[i] = NewTriple(a[i], b[i], c[i])
+	}
+
+	return res
+}
+
+// SliceZip3Pad does the same as SliceZip3 but the result has the len of the
+// longest slice. Missing elements are replaced by `defA`, `defB` and `defC`.
+func SliceZip3Pad[A, B, C any](a []A, b []B, c []C, defA A, defB B, defC C) []Triple[A, B, C] {
+	n := Max(len(a), len(b), len(c))
+	res := make([]Triple[A, B, C], n)
+	for i := 0; i < n; i++ {
+		res[i] = NewTriple(sliceGetDefault(a, i, defA), sliceGetDefault(b, i, defB), sliceGetDefault(c, i, defC))
+	}
+
+	return res
+}
+
+// SliceZip3Strict does the same as SliceZip3 but returns ErrLengthMismatch
+// when slices have different len.
+func SliceZip3Strict[A, B, C any](a []A, b []B, c []C) ([]Triple[A, B, C], error) {
+	if len(a) != len(b) || len(a) != len(c) {
+		return nil, fmt.Errorf("zip %d, %d and %d elements: %w", len(a), len(b), len(c), ErrLengthMismatch)
+	}
+
+	return SliceZip3(a, b, c), nil
+}
+
+// SliceUnzip3 splits triples into three slices. That is the reverse of
+// SliceZip3.
+func SliceUnzip3[A, B, C any](in []Triple[A, B, C]) ([]A, []B, []C) {
+	resA := make([]A, len(in))
+	resB := make([]B, len(in))
+	resC := make([]C, len(in))
+	for i := range in {
+		resA[i], resB[i], resC[i] = in[i].Values()
+	}
+
+	return resA, resB, resC
+}
+
+func sliceGetDefault[T any](in []T, i int, defaultVal T) T {
+	if i < len(in) {
+		return in[i]
+	}
+
+	return defaultVal
+}
+
+// SliceDiffPair contains the same element from the old and the new slices.
+type SliceDiffPair[T any] struct {
+	Old SliceElem[T]
+	New SliceElem[T]
+}
+
+// SliceDiff contains the difference between two slices. Elements of the
+// slices are matched by their keys. When a key occurs several times, the
+// first occurrence in the old slice is matched with the first occurrence in
+// the new slice, and so on.
+type SliceDiff[T any] struct {
+	// Added contains elements of the new slice without a match in the old
+	// one. Indexes point to the new slice.
+	Added []SliceElem[T]
+	// Removed contains elements of the old slice without a match in the new
+	// one. Indexes point to the old slice.
+	Removed []SliceElem[T]
+	// Unchanged contains matched elements with equal values.
+	Unchanged []SliceDiffPair[T]
+	// Changed contains matched elements with different values.
+	Changed []SliceDiffPair[T]
+	// Moved contains matched elements which position relative to other
+	// matched elements was changed. Each of them is also presented in
+	// Unchanged or Changed.
+	Moved []SliceDiffPair[T]
+}
+
+// Empty returns true when both slices contain the same elements in the same
+// order.
+func (d SliceDiff[T]) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0 && len(d.Moved) == 0
+}
+
+// Patch returns the patch which turns the old slice into the new one.
+// See SlicePatchApply.
+func (d SliceDiff[T]) Patch() []SlicePatchOp[T] {
+	moved := make(map[int]struct{}, len(d.Moved))
+	for _, p := range d.Moved {
+		moved[p.New.Idx] = struct{}{}
+	}
+
+	deletes := make([]int, 0, len(d.Removed)+len(d.Moved))
+	for _, e := range d.Removed {
+		deletes = append(deletes, e.Idx)
+	}
+
+	for _, p := range d.Moved {
+		deletes = append(deletes, p.Old.Idx)
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(deletes)))
+
+	res := make([]SlicePatchOp[T], 0, len(deletes)+len(d.Added)+len(d.Moved)+len(d.Changed))
+	for _, idx := range deletes {
+		res = append(res, SlicePatchOp[T]{Kind: SlicePatchDelete, Idx: idx})
+	}
+
+	// All elements which were not deleted are already in the right order,
+	// so the rest of elements can be inserted one by one in order of
+	// their new indexes.
+	puts := make([]SlicePatchOp[T], 0, len(d.Added)+len(d.Moved)+len(d.Changed))
+	for _, e := range d.Added {
+		puts = append(puts, SlicePatchOp[T]{Kind: SlicePatchInsert, Idx: e.Idx, Val: e.Val})
+	}
+
+	for _, p := range d.Moved {
+		puts = append(puts, SlicePatchOp[T]{Kind: SlicePatchInsert, Idx: p.New.Idx, Val: p.New.Val})
+	}
+
+	for _, p := range d.Changed {
+		if _, ok := moved[p.New.Idx]; ok {
+			continue
+		}
+
+		puts = append(puts, SlicePatchOp[T]{Kind: SlicePatchReplace, Idx: p.New.Idx, Val: p.New.Val})
+	}
+
+	sort.Slice(puts, func(i, j int) bool { return puts[i].Idx < puts[j].Idx })
+
+	return append(res, puts...)
+}
+
+// SliceDiffFn returns the difference between `oldSlice` and `newSlice`.
+// Elements are matched by `key` and compared by `equal`.
+// Example: [1,2,3], [3,1,4] => Added: [4], Removed: [2], Unchanged: [1,3],
+// Moved: [3]
+func SliceDiffFn[T any, K comparable](oldSlice, newSlice []T, key func(T) K, equal func(a, b T) bool) SliceDiff[T] {
+	oldIdx := make(map[K][]int, len(oldSlice))
+	for i := range oldSlice {
+		k := key(oldSlice[i])
+		oldIdx[k] = append(oldIdx[k], i)
+	}
+
+	res := SliceDiff[T]{
+		Added:     make([]SliceElem[T], 0),
+		Removed:   make([]SliceElem[T], 0),
+		Unchanged: make([]SliceDiffPair[T], 0),
+		Changed:   make([]SliceDiffPair[T], 0),
+		Moved:     make([]SliceDiffPair[T], 0),
+	}
+
+	matched := make([]bool, len(oldSlice))
+	pairs := make([]SliceDiffPair[T], 0, Min(len(oldSlice), len(newSlice)))
+	for i := range newSlice {
+		k := key(newSlice[i])
+		idxs := oldIdx[k]
+		if len(idxs) == 0 {
+			res.Added = append(res.Added, SliceElem[T]{Idx: i, Val: newSlice[i]})
+			continue
+		}
+
+		oldKeyIdx := idxs[0]
+		oldIdx[k] = idxs[1:]
+		matched[oldKeyIdx] = true
+
+		pair := SliceDiffPair[T]{
+			Old: SliceElem[T]{Idx: oldKeyIdx, Val: oldSlice[oldKeyIdx]},
+			New: SliceElem[T]{Idx: i, Val: newSlice[i]},
+		}
+		pairs = append(pairs, pair)
+
+		if equal(pair.Old.Val, pair.New.Val) {
+			res.Unchanged = append(res.Unchanged, pair)
+		} else {
+			res.Changed = append(res.Changed, pair)
+		}
+	}
+
+	for i := range oldSlice {
+		if !matched[i] {
+			res.Removed = append(res.Removed, SliceElem[T]{Idx: i, Val: oldSlice[i]})
+		}
+	}
+
+	stay := sliceDiffStayIndexes(pairs)
+	for i := range pairs {
+		if _, ok := stay[i]; !ok {
+			res.Moved = append(res.Moved, pairs[i])
+		}
+	}
+
+	return res
+}
+
+// SliceDiffOf returns the difference between `oldSlice` and `newSlice`, where
+// each element is a key itself. See SliceDiffFn.
+func SliceDiffOf[T comparable](oldSlice, newSlice []T) SliceDiff[T] {
+	return SliceDiffFn(oldSlice, newSlice,
+		func(v T) T { return v },
+		func(a, b T) bool { return a == b },
+	)
+}
+
+// sliceDiffStayIndexes returns indexes of `pairs` which form the longest
+// subsequence ordered by old indexes. `pairs` should be ordered by new
+// indexes. All other pairs are considered as moved.
+func sliceDiffStayIndexes[T any](pairs []SliceDiffPair[T]) map[int]struct{} {
+	// tails[l] is an index of the pair which ends the increasing
+	// subsequence of len l+1 with the minimal old index.
+	tails := make([]int, 0, len(pairs))
+	prev := make([]int, len(pairs))
+	for i := range pairs {
+		l := sort.Search(len(tails), func(j int) bool {
+			return pairs[tails[j]].Old.Idx >= pairs[i].Old.Idx
+		})
+
+		prev[i] = -1
+		if l > 0 {
+			prev[i] = tails[l-1]
+		}
+
+		if l == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[l] = i
+		}
+	}
+
+	res := make(map[int]struct{}, len(tails))
+	if len(tails) == 0 {
+		return res
+	}
+
+	for i := tails[len(tails)-1]; i != -1; i = prev[i] {
+		res[i] = struct{}{}
+	}
+
+	return res
+}
+
+// SlicePatchKind defines the kind of the patch operation.
+type SlicePatchKind int
+
+const (
+	// SlicePatchInsert inserts Val at Idx.
+	SlicePatchInsert SlicePatchKind = iota
+	// SlicePatchDelete removes the element at Idx.
+	SlicePatchDelete
+	// SlicePatchReplace replaces the element at Idx with Val.
+	SlicePatchReplace
+)
+
+// SlicePatchOp is a single operation of the patch. Operations are applied
+// one by one, so Idx points to the slice after all previous operations.
+type SlicePatchOp[T any] struct {
+	Kind SlicePatchKind
+	Idx  int
+	Val  T
+}
+
+// ErrPatchIdxOutOfRange returned when the patch operation points out of the
+// slice.
+var ErrPatchIdxOutOfRange = errors.New("patch index out of range")
+
+// SlicePatchApply applies `patch` to `in` and returns the result. The source
+// slice is not modified.
+func SlicePatchApply[T any](in []T, patch []SlicePatchOp[T]) ([]T, error) {
+	res := SliceCopy(in)
+	for i, op := range patch {
+		switch op.Kind {
+		case SlicePatchInsert:
+			if op.Idx < 0 || op.Idx > len(res) {
+				return nil, fmt.Errorf("op %d: insert at %d: %w", i, op.Idx, ErrPatchIdxOutOfRange)
+			}
+
+			var zero T
+			res = append(res, zero)
+			copy(res[op.Idx+1:], res[op.Idx:])
+			res[op.Idx] = op.Val
+
+		case SlicePatchDelete:
+			if op.Idx < 0 || op.Idx >= len(res) {
+				return nil, fmt.Errorf("op %d: delete at %d: %w", i, op.Idx, ErrPatchIdxOutOfRange)
+			}
+
+			res = append(res[:op.Idx], res[op.Idx+1:]...)
+
+		case SlicePatchReplace:
+			if op.Idx < 0 || op.Idx >= len(res) {
+				return nil, fmt.Errorf("op %d: replace at %d: %w", i, op.Idx, ErrPatchIdxOutOfRange)
+			}
+
+			res[op.Idx] = op.Val
+
+		default:
+			return nil, fmt.Errorf("op %d: unknown patch kind %d", i, op.Kind)
+		}
+	}
+
+	return res, nil
+}
+
+// SliceTopK returns `k` greatest elements of `in` according to `less` in
+// descending order. It is faster than sorting the whole slice when `k` is
+// much less than len of `in`. The source slice is not modified. The order
+// of equal elements is not defined.
+// Example: [5,1,4,2,3], 2 => [5,4]
+func SliceTopK[T any](in []T, k int, less func(a, b T) bool) []T {
+	if k <= 0 {
+		return make([]T, 0)
+	}
+
+	h := &sliceHeap[T]{items: SliceCopy(in[:Min(k, len(in))]), less: less}
+	heap.Init(h)
+	for _, v := range in[len(h.items):] {
+		if less(h.items[0], v) {
+			h.items[0] = v
+			heap.Fix(h, 0)
+		}
+	}
+
+	// The heap keeps the least element at the top, so move elements to the
+	// end of the slice one by one to get the descending order.
+	res := h.items
+	for i := len(res) - 1; i > 0; i-- {
+		res[0], res[i] = res[i], res[0]
+		h.items = res[:i]
+		heap.Fix(h, 0)
+	}
+
+	return res
+}
+
+// SliceBottomK returns `k` least elements of `in` according to `less` in
+// ascending order. See SliceTopK.
+// Example: [5,1,4,2,3], 2 => [1,2]
+func SliceBottomK[T any](in []T, k int, less func(a, b T) bool) []T {
+	return SliceTopK(in, k, LessReverse(less))
+}
+
+// SliceNthElement rearranges elements of `in` inplace, so that the element
+// at `n` is the element which would be there if `in` was sorted. All
+// elements before it are not greater than it, all elements after it are
+// not less than it. Works in linear time on average.
+// Example: [5,1,4,2,3], 2 => [1,2,3,5,4] or [2,1,3,4,5], etc.
+func SliceNthElement[T any](in []T, n int, less func(a, b T) bool) {
+	if n < 0 || n >= len(in) {
+		panic("n should be in range [0, len(in))")
+	}
+
+	lo, hi := 0, len(in)
+	// Limit the depth of partitioning to avoid quadratic time on bad inputs.
+	for depth := 2 * bits.Len(uint(len(in))); hi-lo > 1; depth-- {
+		if depth == 0 {
+			SliceSortUnstable(in[lo:hi], less)
+			return
+		}
+
+		lt, gt := slicePartition3(in[lo:hi], less)
+		switch {
+		case n < lo+lt:
+			hi = lo + lt
+		case n >= lo+gt:
+			lo += gt
+		default:
+			return
+		}
+	}
+}
+
+// SlicePartialSort rearranges elements of `in` inplace, so that the first
+// `k` elements are the least elements in ascending order. The order of
+// other elements is not defined.
+// Example: [5,1,4,2,3], 2 => [1,2,...]
+func SlicePartialSort[T any](in []T, k int, less func(a, b T) bool) {
+	if k <= 0 {
+		return
+	}
+
+	if k < len(in) {
+		SliceNthElement(in, k, less)
+	} else {
+		k = len(in)
+	}
+
+	SliceSortUnstable(in[:k], less)
+}
+
+// slicePartition3 partitions `in` around the median of three elements into
+// elements less than pivot [0, lt), equal to pivot [lt, gt) and greater
+// than pivot [gt, len).
+func slicePartition3[T any](in []T, less func(a, b T) bool) (int, int) {
+	a, b, c := 0, len(in)/2, len(in)-1
+	if less(in[b], in[a]) {
+		a, b = b, a
+	}
+
+	if less(in[c], in[b]) {
+		b = c
+		if less(in[b], in[a]) {
+			b = a
+		}
+	}
+
+	pivot := in[b]
+	lt, i, gt := 0, 0, len(in)
+	for i < gt {
+		switch {
+		case less(in[i], pivot):
+			in[lt], in[i] = in[i], in[lt]
+			lt++
+			i++
+		case less(pivot, in[i]):
+			gt--
+			in[gt], in[i] = in[i], in[gt]
+		default:
+			i++
+		}
+	}
+
+	return lt, gt
+}
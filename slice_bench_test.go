@@ -1113,3 +1113,71 @@ func BenchmarkSliceLastN(b *testing.B) {
 		}
 	}
 }
+
+// BenchmarkSliceTopK compares SliceTopK, SlicePartialSort and the full sort
+// for selecting the best elements.
+func BenchmarkSliceTopK(b *testing.B) {
+	sizes := []int{1000, 100000}
+	kValues := []int{10, 100}
+
+	for _, size := range sizes {
+		slice := make([]int, size)
+		for i := 0; i < size; i++ {
+			slice[i] = rand.Intn(size)
+		}
+		less := func(a, b int) bool { return a < b }
+
+		for _, k := range kValues {
+			b.Run(fmt.Sprintf("size_%d_k_%d/top_k", size, k), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					_ = just.SliceTopK(slice, k, less)
+				}
+			})
+
+			b.Run(fmt.Sprintf("size_%d_k_%d/partial_sort", size, k), func(b *testing.B) {
+				buf := make([]int, size)
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					copy(buf, slice)
+					just.SlicePartialSort(buf, k, less)
+				}
+			})
+
+			b.Run(fmt.Sprintf("size_%d_k_%d/sort_copy", size, k), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					_ = just.SliceGetFirstN(just.SliceSortCopy(slice, less), k)
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkSliceNthElement compares SliceNthElement with the full sort for
+// finding the median.
+func BenchmarkSliceNthElement(b *testing.B) {
+	sizes := []int{1000, 100000}
+
+	for _, size := range sizes {
+		slice := make([]int, size)
+		for i := 0; i < size; i++ {
+			slice[i] = rand.Intn(size)
+		}
+		less := func(a, b int) bool { return a < b }
+		buf := make([]int, size)
+
+		b.Run(fmt.Sprintf("size_%d/nth_element", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(buf, slice)
+				just.SliceNthElement(buf, size/2, less)
+			}
+		})
+
+		b.Run(fmt.Sprintf("size_%d/sort", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(buf, slice)
+				just.SliceSortUnstable(buf, less)
+			}
+		})
+	}
+}
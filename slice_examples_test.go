@@ -87,3 +87,56 @@ func ExampleSlice2Chan() {
 	fmt.Println(result)
 	// Output: [10 20 30 40 50]
 }
+
+func ExampleSlicePaginate() {
+	input := []int{10, 20, 30, 40, 50}
+	page := just.SlicePaginate(input, 2, 2)
+	fmt.Println(page.Items, page.TotalPages, page.HasNext())
+	// Output: [30 40] 3 true
+}
+
+func ExampleSliceWindows() {
+	input := []int{10, 20, 30, 40}
+	result := just.SliceWindows(input, 2, 1)
+	fmt.Println(result)
+	// Output: [[10 20] [20 30] [30 40]]
+}
+
+func ExampleSliceDiffOf() {
+	oldSlice := []int{10, 20, 30}
+	newSlice := []int{30, 10, 40}
+	diff := just.SliceDiffOf(oldSlice, newSlice)
+	fmt.Println(len(diff.Added), len(diff.Removed), len(diff.Moved))
+
+	result, _ := just.SlicePatchApply(oldSlice, diff.Patch())
+	fmt.Println(result)
+	// Output:
+	// 1 1 1
+	// [30 10 40]
+}
+
+func ExampleLessThen() {
+	type user struct {
+		Name string
+		Age  int
+	}
+
+	input := []user{{"bob", 30}, {"alice", 25}, {"carl", 30}}
+	just.SliceSort(input, just.LessThen(
+		just.LessReverse(just.LessBy(func(u user) int { return u.Age })),
+		just.LessBy(func(u user) string { return u.Name }),
+	))
+	fmt.Println(input)
+	// Output: [{bob 30} {carl 30} {alice 25}]
+}
+
+func ExampleSliceGroupByOrdered() {
+	input := []string{"apple", "avocado", "banana", "apricot", "blueberry"}
+	result := just.SliceGroupByOrdered(input, func(s string) byte { return s[0] })
+	for _, group := range result {
+		fmt.Println(string(group.Key), group.Val)
+	}
+	// Output:
+	// a [apple avocado apricot]
+	// b [banana blueberry]
+}
@@ -2,6 +2,8 @@ package just_test
 
 import (
 	"errors"
+	"math"
+	"math/rand"
 	"strconv"
 	"testing"
 	"time"
@@ -934,6 +936,18 @@ func TestSliceSort(t *testing.T) {
 	assert.Equal(t, []int{1, 2, 3}, a)
 }
 
+func TestSliceSortUnstable(t *testing.T) {
+	t.Parallel()
+
+	a := []int{5, 1, 3, 2, 4, 3}
+	just.SliceSortUnstable(a, less)
+	assert.Equal(t, []int{1, 2, 3, 3, 4, 5}, a)
+
+	var empty []int
+	just.SliceSortUnstable(empty, less)
+	assert.Empty(t, empty)
+}
+
 func TestSliceElem(t *testing.T) {
 	t.Parallel()
 
@@ -2137,3 +2151,970 @@ func TestSlice2Iter(t *testing.T) {
 		assert.Equal(t, []int{1, 2, 3}, values)
 	})
 }
+
+func TestSliceNullHelpers(t *testing.T) {
+	t.Parallel()
+
+	in := []just.NullVal[int]{just.Null(1), just.NullNull[int](), just.Null(0)}
+	allInvalid := []just.NullVal[int]{just.NullNull[int](), just.NullNull[int]()}
+	allValid := []just.NullVal[int]{just.Null(1), just.Null(2)}
+
+	t.Run("values", func(t *testing.T) {
+		assert.Equal(t, []int{1, 0}, just.SliceNullValues(in))
+		assert.Equal(t, []int{}, just.SliceNullValues(allInvalid))
+		assert.Equal(t, []int{}, just.SliceNullValues[int](nil))
+	})
+
+	t.Run("default", func(t *testing.T) {
+		assert.Equal(t, []int{1, 42, 0}, just.SliceNullDefault(in, 42))
+		assert.Equal(t, []int{}, just.SliceNullDefault[int](nil, 42))
+	})
+
+	t.Run("all_any_valid", func(t *testing.T) {
+		assert.False(t, just.SliceNullAllValid(in))
+		assert.True(t, just.SliceNullAllValid(allValid))
+		assert.True(t, just.SliceNullAllValid[int](nil))
+
+		assert.True(t, just.SliceNullAnyValid(in))
+		assert.False(t, just.SliceNullAnyValid(allInvalid))
+		assert.False(t, just.SliceNullAnyValid[int](nil))
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		ptrs := just.SliceNull2Pointers(in)
+		assert.Equal(t, []*int{just.Pointer(1), nil, just.Pointer(0)}, ptrs)
+		assert.Equal(t, in, just.SlicePointers2Null(ptrs))
+		assert.Equal(t, []just.NullVal[int]{}, just.SlicePointers2Null[int](nil))
+	})
+}
+
+func TestSliceIsSorted(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		name string
+		in   []int
+		exp  bool
+	}{
+		{name: "nil", in: nil, exp: true},
+		{name: "one", in: []int{1}, exp: true},
+		{name: "sorted", in: []int{1, 2, 2, 3}, exp: true},
+		{name: "unsorted", in: []int{1, 3, 2}, exp: false},
+		{name: "reversed", in: []int{3, 2, 1}, exp: false},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(row.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, row.exp, just.SliceIsSorted(row.in, less))
+		})
+	}
+}
+
+func TestSliceBounds(t *testing.T) {
+	t.Parallel()
+
+	in := []int{1, 2, 2, 2, 5}
+	table := []struct {
+		elem  int
+		lower int
+		upper int
+	}{
+		{elem: 0, lower: 0, upper: 0},
+		{elem: 1, lower: 0, upper: 1},
+		{elem: 2, lower: 1, upper: 4},
+		{elem: 3, lower: 4, upper: 4},
+		{elem: 5, lower: 4, upper: 5},
+		{elem: 6, lower: 5, upper: 5},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(strconv.Itoa(row.elem), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, row.lower, just.SliceLowerBound(in, row.elem, less))
+			assert.Equal(t, row.upper, just.SliceUpperBound(in, row.elem, less))
+		})
+	}
+
+	assert.Equal(t, 0, just.SliceLowerBound(nil, 1, less))
+	assert.Equal(t, 0, just.SliceUpperBound(nil, 1, less))
+}
+
+func TestSliceBinarySearch(t *testing.T) {
+	t.Parallel()
+
+	in := []int{1, 3, 3, 7}
+	table := []struct {
+		elem int
+		exp  just.SliceElem[int]
+	}{
+		{elem: 0, exp: just.SliceElem[int]{Idx: -1}},
+		{elem: 1, exp: just.SliceElem[int]{Idx: 0, Val: 1}},
+		{elem: 3, exp: just.SliceElem[int]{Idx: 1, Val: 3}},
+		{elem: 5, exp: just.SliceElem[int]{Idx: -1}},
+		{elem: 7, exp: just.SliceElem[int]{Idx: 3, Val: 7}},
+		{elem: 8, exp: just.SliceElem[int]{Idx: -1}},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(strconv.Itoa(row.elem), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, row.exp, just.SliceBinarySearch(in, row.elem))
+			assert.Equal(t, row.exp, just.SliceBinarySearchFn(in, row.elem, less))
+		})
+	}
+
+	t.Run("by_key", func(t *testing.T) {
+		type user struct {
+			ID   int
+			Name string
+		}
+
+		users := []user{{ID: 1, Name: "a"}, {ID: 4, Name: "b"}, {ID: 9, Name: "c"}}
+		byID := func(a, b user) bool { return a.ID < b.ID }
+
+		res := just.SliceBinarySearchFn(users, user{ID: 4}, byID)
+		assert.Equal(t, just.SliceElem[user]{Idx: 1, Val: user{ID: 4, Name: "b"}}, res)
+		assert.False(t, just.SliceBinarySearchFn(users, user{ID: 5}, byID).Ok())
+	})
+
+	t.Run("strings", func(t *testing.T) {
+		assert.Equal(t, 1, just.SliceBinarySearch([]string{"a", "b", "c"}, "b").Idx)
+		assert.Equal(t, -1, just.SliceBinarySearch([]string{}, "b").Idx)
+	})
+}
+
+func TestSliceInsertSorted(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		key int
+		val string
+	}
+
+	byKey := func(a, b item) bool { return a.key < b.key }
+
+	var res []item
+	for _, it := range []item{{3, "a"}, {1, "b"}, {3, "c"}, {2, "d"}, {0, "e"}, {4, "f"}} {
+		res = just.SliceInsertSorted(res, it, byKey)
+		require.True(t, just.SliceIsSorted(res, byKey))
+	}
+
+	assert.Equal(t, []item{{0, "e"}, {1, "b"}, {2, "d"}, {3, "a"}, {3, "c"}, {4, "f"}}, res)
+}
+
+func TestSliceUniqSorted(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		name string
+		in   []int
+		exp  []int
+	}{
+		{name: "nil", in: nil, exp: []int{}},
+		{name: "one", in: []int{1}, exp: []int{1}},
+		{name: "uniq", in: []int{1, 2, 3}, exp: []int{1, 2, 3}},
+		{name: "dups", in: []int{1, 1, 2, 3, 3, 3}, exp: []int{1, 2, 3}},
+		{name: "all_same", in: []int{5, 5, 5}, exp: []int{5}},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(row.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, row.exp, just.SliceUniqSorted(row.in, less))
+		})
+	}
+}
+
+func TestSliceMergeSorted(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		name string
+		in   [][]int
+		exp  []int
+	}{
+		{name: "nothing", in: nil, exp: []int{}},
+		{name: "empty", in: [][]int{nil, {}}, exp: []int{}},
+		{name: "one", in: [][]int{{1, 2}}, exp: []int{1, 2}},
+		{name: "two", in: [][]int{{1, 4}, {2, 3, 5}}, exp: []int{1, 2, 3, 4, 5}},
+		{name: "three", in: [][]int{{5, 6}, {1, 9}, nil, {0, 5, 7}}, exp: []int{0, 1, 5, 5, 6, 7, 9}},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(row.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, row.exp, just.SliceMergeSorted(less, row.in...))
+		})
+	}
+
+	t.Run("stable", func(t *testing.T) {
+		type item struct {
+			key int
+			src string
+		}
+
+		byKey := func(a, b item) bool { return a.key < b.key }
+		res := just.SliceMergeSorted(byKey,
+			[]item{{1, "a"}, {2, "a"}},
+			[]item{{1, "b"}, {2, "b"}},
+			[]item{{1, "c"}},
+		)
+		assert.Equal(t, []item{{1, "a"}, {1, "b"}, {1, "c"}, {2, "a"}, {2, "b"}}, res)
+	})
+}
+
+func TestSliceRangeFloat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no_accumulated_error", func(t *testing.T) {
+		res := just.SliceRange(0.0, 1.0, 0.1)
+		require.Len(t, res, 10)
+		assert.Equal(t, 0.7000000000000001, res[7])
+		assert.Equal(t, 0.9, res[9])
+
+		res = just.SliceRange(0.0, 10.0, 0.01)
+		assert.Len(t, res, 1000)
+		assert.Less(t, res[len(res)-1], 10.0)
+	})
+
+	t.Run("decreasing", func(t *testing.T) {
+		res := just.SliceRange(1.0, 0.0, -0.25)
+		assert.Equal(t, []float64{1, 0.75, 0.5, 0.25}, res)
+	})
+
+	t.Run("float32", func(t *testing.T) {
+		res := just.SliceRange[float32](0, 1, 0.1)
+		assert.Len(t, res, 10)
+		for _, v := range res {
+			assert.Less(t, v, float32(1))
+		}
+	})
+}
+
+func TestSliceRangeCount(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		name string
+		fn   func() (int, int)
+	}{
+		{name: "int", fn: func() (int, int) { return just.SliceRangeCount(0, 10, 3), 4 }},
+		{name: "int_exact", fn: func() (int, int) { return just.SliceRangeCount(0, 9, 3), 3 }},
+		{name: "int_negative_step", fn: func() (int, int) { return just.SliceRangeCount(10, 0, -3), 4 }},
+		{name: "int_wrong_direction", fn: func() (int, int) { return just.SliceRangeCount(0, 10, -1), 0 }},
+		{name: "int_zero_step", fn: func() (int, int) { return just.SliceRangeCount(0, 10, 0), 0 }},
+		{name: "int_empty", fn: func() (int, int) { return just.SliceRangeCount(5, 5, 1), 0 }},
+		{name: "int8_full", fn: func() (int, int) { return just.SliceRangeCount[int8](-128, 127, 1), 255 }},
+		{name: "int8_full_reverse", fn: func() (int, int) { return just.SliceRangeCount[int8](127, -128, -128), 2 }},
+		{name: "uint8_full", fn: func() (int, int) { return just.SliceRangeCount[uint8](0, 255, 1), 255 }},
+		{name: "uint_reverse", fn: func() (int, int) { return just.SliceRangeCount[uint](10, 0, 1), 0 }},
+		{name: "int64_huge", fn: func() (int, int) { return just.SliceRangeCount[int64](math.MinInt64, math.MaxInt64, math.MaxInt64), 3 }},
+		{name: "float", fn: func() (int, int) { return just.SliceRangeCount(0.0, 1.0, 0.1), 10 }},
+		{name: "float_exact", fn: func() (int, int) { return just.SliceRangeCount(0.0, 1.0, 0.25), 4 }},
+		{name: "float_not_exact", fn: func() (int, int) { return just.SliceRangeCount(0.0, 1.0, 0.3), 4 }},
+		{name: "float_reverse", fn: func() (int, int) { return just.SliceRangeCount(1.0, 0.0, -0.1), 10 }},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(row.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, exp := row.fn()
+			assert.Equal(t, exp, res)
+		})
+	}
+}
+
+func TestSliceRangeIter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all", func(t *testing.T) {
+		var idxs []int
+		var elems []int8
+		just.SliceRangeIter[int8](-128, 127, 100)(func(i int, elem int8) bool {
+			idxs = append(idxs, i)
+			elems = append(elems, elem)
+			return true
+		})
+
+		assert.Equal(t, []int{0, 1, 2}, idxs)
+		assert.Equal(t, []int8{-128, -28, 72}, elems)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		var elems []int
+		just.SliceRangeIter(0, 1_000_000_000, 1)(func(_ int, elem int) bool {
+			elems = append(elems, elem)
+			return elem < 2
+		})
+
+		assert.Equal(t, []int{0, 1, 2}, elems)
+	})
+
+	t.Run("float", func(t *testing.T) {
+		var last float64
+		just.SliceRangeIter(0.0, 1.0, 0.1)(func(i int, elem float64) bool {
+			last = elem
+			return true
+		})
+
+		assert.Equal(t, 0.9, last)
+	})
+}
+
+func TestSliceLinspace(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []float64{}, just.SliceLinspace(0.0, 1.0, 0))
+	assert.Equal(t, []float64{}, just.SliceLinspace(0.0, 1.0, -1))
+	assert.Equal(t, []float64{2}, just.SliceLinspace(2.0, 1.0, 1))
+	assert.Equal(t, []float64{0, 0.25, 0.5, 0.75, 1}, just.SliceLinspace(0.0, 1.0, 5))
+	assert.Equal(t, []float64{1, 0.5, 0}, just.SliceLinspace(1.0, 0.0, 3))
+
+	res := just.SliceLinspace[float32](0, 0.3, 4)
+	assert.Len(t, res, 4)
+	assert.Equal(t, float32(0.3), res[3])
+}
+
+func TestSlicePaginate(t *testing.T) {
+	t.Parallel()
+
+	in := []int{1, 2, 3, 4, 5, 6, 7}
+
+	table := []struct {
+		name    string
+		in      []int
+		page    int
+		size    int
+		exp     []int
+		total   int
+		hasNext bool
+		hasPrev bool
+	}{
+		{name: "first", in: in, page: 1, size: 3, exp: []int{1, 2, 3}, total: 3, hasNext: true},
+		{name: "middle", in: in, page: 2, size: 3, exp: []int{4, 5, 6}, total: 3, hasNext: true, hasPrev: true},
+		{name: "last", in: in, page: 3, size: 3, exp: []int{7}, total: 3, hasPrev: true},
+		{name: "beyond", in: in, page: 4, size: 3, exp: []int{}, total: 3, hasPrev: true},
+		{name: "far_beyond", in: in, page: math.MaxInt, size: 3, exp: []int{}, total: 3, hasPrev: true},
+		{name: "exact", in: in[:6], page: 2, size: 3, exp: []int{4, 5, 6}, total: 2, hasPrev: true},
+		{name: "empty", in: nil, page: 1, size: 3, exp: []int{}, total: 0},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(row.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := just.SlicePaginate(row.in, row.page, row.size)
+			assert.Equal(t, row.exp, res.Items)
+			assert.Equal(t, row.page, res.Page)
+			assert.Equal(t, row.size, res.Size)
+			assert.Equal(t, len(row.in), res.TotalItems)
+			assert.Equal(t, row.total, res.TotalPages)
+			assert.Equal(t, row.hasNext, res.HasNext())
+			assert.Equal(t, row.hasPrev, res.HasPrev())
+		})
+	}
+
+	t.Run("invalid_args", func(t *testing.T) {
+		assert.Panics(t, func() { just.SlicePaginate(in, 0, 1) })
+		assert.Panics(t, func() { just.SlicePaginate(in, 1, 0) })
+	})
+}
+
+func TestSliceOffsetLimit(t *testing.T) {
+	t.Parallel()
+
+	in := []int{1, 2, 3, 4, 5}
+	assert.Equal(t, []int{1, 2}, just.SliceOffsetLimit(in, 0, 2))
+	assert.Equal(t, []int{4, 5}, just.SliceOffsetLimit(in, 3, 10))
+	assert.Equal(t, []int{}, just.SliceOffsetLimit(in, 5, 10))
+	assert.Equal(t, []int{}, just.SliceOffsetLimit(in, 3, 0))
+	assert.Panics(t, func() { just.SliceOffsetLimit(in, -1, 1) })
+	assert.Panics(t, func() { just.SliceOffsetLimit(in, 1, -1) })
+}
+
+func TestSliceCursorPage(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		ID int
+	}
+
+	in := []item{{ID: 1}, {ID: 3}, {ID: 4}, {ID: 8}, {ID: 9}}
+	id := func(it item) int { return it.ID }
+
+	t.Run("walk_all_pages", func(t *testing.T) {
+		var pages [][]item
+		cursor := just.NullNull[int]()
+		for {
+			page, next, err := just.SliceCursorPage(in, id, cursor, 2)
+			require.NoError(t, err)
+			pages = append(pages, page)
+			if !next.Valid {
+				break
+			}
+
+			cursor = next
+		}
+
+		assert.Equal(t, [][]item{{{ID: 1}, {ID: 3}}, {{ID: 4}, {ID: 8}}, {{ID: 9}}}, pages)
+	})
+
+	t.Run("cursor_not_in_slice", func(t *testing.T) {
+		page, next, err := just.SliceCursorPage(in, id, just.Null(5), 1)
+		require.NoError(t, err)
+		assert.Equal(t, []item{{ID: 8}}, page)
+		assert.Equal(t, just.Null(8), next)
+	})
+
+	t.Run("exact_end", func(t *testing.T) {
+		page, next, err := just.SliceCursorPage(in, id, just.Null(4), 2)
+		require.NoError(t, err)
+		assert.Equal(t, []item{{ID: 8}, {ID: 9}}, page)
+		assert.False(t, next.Valid)
+	})
+
+	t.Run("after_last", func(t *testing.T) {
+		page, next, err := just.SliceCursorPage(in, id, just.Null(9), 2)
+		require.NoError(t, err)
+		assert.Equal(t, []item{}, page)
+		assert.False(t, next.Valid)
+	})
+
+	t.Run("duplicate_keys", func(t *testing.T) {
+		in := []item{{ID: 1}, {ID: 2}, {ID: 2}, {ID: 3}}
+
+		_, _, err := just.SliceCursorPage(in, id, just.NullNull[int](), 2)
+		assert.ErrorIs(t, err, just.ErrDuplicateKey)
+
+		// Duplicates inside the page do not break the cursor.
+		page, next, err := just.SliceCursorPage(in, id, just.NullNull[int](), 3)
+		require.NoError(t, err)
+		assert.Equal(t, []item{{ID: 1}, {ID: 2}, {ID: 2}}, page)
+		assert.Equal(t, just.Null(2), next)
+	})
+
+	t.Run("invalid_limit", func(t *testing.T) {
+		assert.Panics(t, func() { just.SliceCursorPage(in, id, just.NullNull[int](), 0) })
+	})
+}
+
+func TestSliceWindows(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		name string
+		in   []int
+		size int
+		step int
+		exp  [][]int
+	}{
+		{name: "empty", in: nil, size: 2, step: 1, exp: [][]int{}},
+		{name: "too_short", in: []int{1}, size: 2, step: 1, exp: [][]int{}},
+		{name: "step_1", in: []int{1, 2, 3, 4}, size: 2, step: 1, exp: [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{name: "step_2", in: []int{1, 2, 3, 4, 5}, size: 3, step: 2, exp: [][]int{{1, 2, 3}, {3, 4, 5}}},
+		{name: "step_gt_size", in: []int{1, 2, 3, 4, 5, 6}, size: 2, step: 3, exp: [][]int{{1, 2}, {4, 5}}},
+		{name: "one_window", in: []int{1, 2, 3}, size: 3, step: 1, exp: [][]int{{1, 2, 3}}},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(row.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, row.exp, just.SliceWindows(row.in, row.size, row.step))
+		})
+	}
+
+	t.Run("append_does_not_overwrite_source", func(t *testing.T) {
+		in := []int{1, 2, 3}
+		windows := just.SliceWindows(in, 2, 1)
+		_ = append(windows[0], 42)
+		assert.Equal(t, []int{1, 2, 3}, in)
+	})
+
+	t.Run("invalid_args", func(t *testing.T) {
+		assert.Panics(t, func() { just.SliceWindows([]int{1}, 0, 1) })
+		assert.Panics(t, func() { just.SliceWindows([]int{1}, 1, 0) })
+	})
+}
+
+func TestSlicePairwise(t *testing.T) {
+	t.Parallel()
+
+	collect := func(in []int, limit int) [][2]int {
+		res := make([][2]int, 0)
+		just.SlicePairwise(in)(func(a, b int) bool {
+			res = append(res, [2]int{a, b})
+			return len(res) < limit
+		})
+
+		return res
+	}
+
+	assert.Equal(t, [][2]int{}, collect(nil, 10))
+	assert.Equal(t, [][2]int{}, collect([]int{1}, 10))
+	assert.Equal(t, [][2]int{{1, 2}, {2, 3}, {3, 4}}, collect([]int{1, 2, 3, 4}, 10))
+	assert.Equal(t, [][2]int{{1, 2}}, collect([]int{1, 2, 3, 4}, 1))
+}
+
+func TestSliceZip2(t *testing.T) {
+	t.Parallel()
+
+	ids := []int{1, 2, 3}
+	names := []string{"a", "b"}
+
+	t.Run("truncate", func(t *testing.T) {
+		assert.Equal(t, []just.Pair[int, string]{{1, "a"}, {2, "b"}}, just.SliceZip2(ids, names))
+		assert.Equal(t, []just.Pair[int, string]{}, just.SliceZip2[int, string](ids, nil))
+	})
+
+	t.Run("pad", func(t *testing.T) {
+		assert.Equal(t, []just.Pair[int, string]{{1, "a"}, {2, "b"}, {3, "?"}}, just.SliceZip2Pad(ids, names, 0, "?"))
+		assert.Equal(t, []just.Pair[int, string]{{-1, "a"}, {-1, "b"}}, just.SliceZip2Pad[int, string](nil, names, -1, "?"))
+		assert.Equal(t, []just.Pair[int, string]{}, just.SliceZip2Pad[int, string](nil, nil, -1, "?"))
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		res, err := just.SliceZip2Strict(ids, names)
+		assert.ErrorIs(t, err, just.ErrLengthMismatch)
+		assert.Nil(t, res)
+
+		res, err = just.SliceZip2Strict(ids[:2], names)
+		assert.NoError(t, err)
+		assert.Equal(t, []just.Pair[int, string]{{1, "a"}, {2, "b"}}, res)
+	})
+
+	t.Run("unzip", func(t *testing.T) {
+		a, b := just.SliceUnzip2(just.SliceZip2(ids, names))
+		assert.Equal(t, []int{1, 2}, a)
+		assert.Equal(t, []string{"a", "b"}, b)
+
+		a, b = just.SliceUnzip2[int, string](nil)
+		assert.Equal(t, []int{}, a)
+		assert.Equal(t, []string{}, b)
+	})
+}
+
+func TestSliceZip3(t *testing.T) {
+	t.Parallel()
+
+	ids := []int{1, 2, 3}
+	names := []string{"a", "b"}
+	flags := []bool{true, false, true, false}
+
+	t.Run("truncate", func(t *testing.T) {
+		exp := []just.Triple[int, string, bool]{{1, "a", true}, {2, "b", false}}
+		assert.Equal(t, exp, just.SliceZip3(ids, names, flags))
+	})
+
+	t.Run("pad", func(t *testing.T) {
+		exp := []just.Triple[int, string, bool]{{1, "a", true}, {2, "b", false}, {3, "?", true}, {0, "?", false}}
+		assert.Equal(t, exp, just.SliceZip3Pad(ids, names, flags, 0, "?", true))
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		res, err := just.SliceZip3Strict(ids, names, flags)
+		assert.ErrorIs(t, err, just.ErrLengthMismatch)
+		assert.Nil(t, res)
+
+		res, err = just.SliceZip3Strict(ids[:2], names, flags[:2])
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+	})
+
+	t.Run("unzip", func(t *testing.T) {
+		a, b, c := just.SliceUnzip3(just.SliceZip3(ids, names, flags))
+		assert.Equal(t, []int{1, 2}, a)
+		assert.Equal(t, []string{"a", "b"}, b)
+		assert.Equal(t, []bool{true, false}, c)
+	})
+}
+
+func TestSliceDiffOf(t *testing.T) {
+	t.Parallel()
+
+	elem := func(idx, val int) just.SliceElem[int] {
+		return just.SliceElem[int]{Idx: idx, Val: val}
+	}
+	pair := func(oldIdx, newIdx, val int) just.SliceDiffPair[int] {
+		return just.SliceDiffPair[int]{Old: elem(oldIdx, val), New: elem(newIdx, val)}
+	}
+
+	diff := just.SliceDiffOf([]int{1, 2, 3}, []int{3, 1, 4})
+	assert.Equal(t, []just.SliceElem[int]{elem(2, 4)}, diff.Added)
+	assert.Equal(t, []just.SliceElem[int]{elem(1, 2)}, diff.Removed)
+	assert.Equal(t, []just.SliceDiffPair[int]{pair(2, 0, 3), pair(0, 1, 1)}, diff.Unchanged)
+	assert.Equal(t, []just.SliceDiffPair[int]{}, diff.Changed)
+	assert.Equal(t, []just.SliceDiffPair[int]{pair(2, 0, 3)}, diff.Moved)
+	assert.False(t, diff.Empty())
+
+	t.Run("equal", func(t *testing.T) {
+		diff := just.SliceDiffOf([]int{1, 2, 2}, []int{1, 2, 2})
+		assert.True(t, diff.Empty())
+		assert.Len(t, diff.Unchanged, 3)
+	})
+
+	t.Run("duplicates", func(t *testing.T) {
+		diff := just.SliceDiffOf([]int{1, 1, 2}, []int{1, 2})
+		assert.Equal(t, []just.SliceElem[int]{elem(1, 1)}, diff.Removed)
+		assert.Equal(t, []just.SliceDiffPair[int]{}, diff.Moved)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		diff := just.SliceDiffOf([]int(nil), nil)
+		assert.True(t, diff.Empty())
+		assert.Empty(t, diff.Patch())
+	})
+}
+
+func TestSliceDiffFn(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		ID   int
+		Name string
+	}
+
+	oldSlice := []user{{1, "a"}, {2, "b"}, {3, "c"}, {4, "d"}}
+	newSlice := []user{{2, "b"}, {4, "D"}, {1, "a"}, {5, "e"}}
+
+	diff := just.SliceDiffFn(oldSlice, newSlice,
+		func(u user) int { return u.ID },
+		func(a, b user) bool { return a == b },
+	)
+
+	assert.Equal(t, []just.SliceElem[user]{{Idx: 3, Val: user{5, "e"}}}, diff.Added)
+	assert.Equal(t, []just.SliceElem[user]{{Idx: 2, Val: user{3, "c"}}}, diff.Removed)
+	assert.Equal(t, []just.SliceDiffPair[user]{{
+		Old: just.SliceElem[user]{Idx: 3, Val: user{4, "d"}},
+		New: just.SliceElem[user]{Idx: 1, Val: user{4, "D"}},
+	}}, diff.Changed)
+	assert.Equal(t, []just.SliceDiffPair[user]{{
+		Old: just.SliceElem[user]{Idx: 0, Val: user{1, "a"}},
+		New: just.SliceElem[user]{Idx: 2, Val: user{1, "a"}},
+	}}, diff.Moved)
+
+	res, err := just.SlicePatchApply(oldSlice, diff.Patch())
+	require.NoError(t, err)
+	assert.Equal(t, newSlice, res)
+	assert.Equal(t, user{1, "a"}, oldSlice[0])
+}
+
+func TestSliceDiffPatch(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		name string
+		old  []int
+		new  []int
+	}{
+		{name: "empty", old: nil, new: nil},
+		{name: "only_added", old: nil, new: []int{1, 2, 3}},
+		{name: "only_removed", old: []int{1, 2, 3}, new: nil},
+		{name: "reversed", old: []int{1, 2, 3, 4}, new: []int{4, 3, 2, 1}},
+		{name: "rotated", old: []int{1, 2, 3, 4}, new: []int{2, 3, 4, 1}},
+		{name: "mixed", old: []int{1, 2, 3, 4, 5, 6}, new: []int{7, 6, 1, 3, 8, 2, 5}},
+		{name: "duplicates", old: []int{1, 1, 2, 2, 3}, new: []int{2, 1, 3, 3, 1}},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(row.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := just.SlicePatchApply(row.old, just.SliceDiffOf(row.old, row.new).Patch())
+			require.NoError(t, err)
+			assert.Equal(t, just.SliceCopy(row.new), res)
+		})
+	}
+
+	t.Run("random", func(t *testing.T) {
+		t.Parallel()
+
+		rnd := rand.New(rand.NewSource(1))
+		for i := 0; i < 200; i++ {
+			oldSlice := make([]int, rnd.Intn(10))
+			for j := range oldSlice {
+				oldSlice[j] = rnd.Intn(8)
+			}
+			newSlice := make([]int, rnd.Intn(10))
+			for j := range newSlice {
+				newSlice[j] = rnd.Intn(8)
+			}
+
+			res, err := just.SlicePatchApply(oldSlice, just.SliceDiffOf(oldSlice, newSlice).Patch())
+			require.NoError(t, err)
+			require.Equal(t, just.SliceCopy(newSlice), res, "%v => %v", oldSlice, newSlice)
+		}
+	})
+}
+
+func TestSlicePatchApply(t *testing.T) {
+	t.Parallel()
+
+	res, err := just.SlicePatchApply([]int{1, 2, 3}, []just.SlicePatchOp[int]{
+		{Kind: just.SlicePatchDelete, Idx: 0},
+		{Kind: just.SlicePatchInsert, Idx: 2, Val: 4},
+		{Kind: just.SlicePatchReplace, Idx: 0, Val: 5},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{5, 3, 4}, res)
+
+	table := []just.SlicePatchOp[int]{
+		{Kind: just.SlicePatchInsert, Idx: 4},
+		{Kind: just.SlicePatchInsert, Idx: -1},
+		{Kind: just.SlicePatchDelete, Idx: 3},
+		{Kind: just.SlicePatchReplace, Idx: 3},
+	}
+	for _, op := range table {
+		_, err := just.SlicePatchApply([]int{1, 2, 3}, []just.SlicePatchOp[int]{op})
+		assert.ErrorIs(t, err, just.ErrPatchIdxOutOfRange)
+	}
+
+	_, err = just.SlicePatchApply([]int{1}, []just.SlicePatchOp[int]{{Kind: 42}})
+	assert.Error(t, err)
+}
+
+func TestSliceTopK(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		name      string
+		in        []int
+		k         int
+		expTop    []int
+		expBottom []int
+	}{
+		{name: "empty", in: nil, k: 2, expTop: []int{}, expBottom: []int{}},
+		{name: "zero_k", in: []int{1, 2}, k: 0, expTop: []int{}, expBottom: []int{}},
+		{name: "negative_k", in: []int{1, 2}, k: -1, expTop: []int{}, expBottom: []int{}},
+		{name: "k_gt_len", in: []int{2, 3, 1}, k: 5, expTop: []int{3, 2, 1}, expBottom: []int{1, 2, 3}},
+		{name: "simple", in: []int{5, 1, 4, 2, 3}, k: 2, expTop: []int{5, 4}, expBottom: []int{1, 2}},
+		{name: "duplicates", in: []int{1, 3, 3, 2, 3, 1}, k: 3, expTop: []int{3, 3, 3}, expBottom: []int{1, 1, 2}},
+	}
+
+	for _, row := range table {
+		row := row
+		t.Run(row.name, func(t *testing.T) {
+			t.Parallel()
+
+			src := just.SliceCopy(row.in)
+			assert.Equal(t, row.expTop, just.SliceTopK(row.in, row.k, less))
+			assert.Equal(t, row.expBottom, just.SliceBottomK(row.in, row.k, less))
+			assert.Equal(t, src, just.SliceCopy(row.in))
+		})
+	}
+
+	t.Run("random", func(t *testing.T) {
+		t.Parallel()
+
+		rnd := rand.New(rand.NewSource(1))
+		for i := 0; i < 100; i++ {
+			in := make([]int, rnd.Intn(50))
+			for j := range in {
+				in[j] = rnd.Intn(20)
+			}
+			k := rnd.Intn(60)
+
+			sorted := just.SliceSortCopy(in, less)
+			expBottom := just.SliceGetFirstN(sorted, k)
+			expTop := just.SliceGetFirstN(just.SliceReverse(sorted), k)
+			require.Equal(t, expTop, just.SliceTopK(in, k, less))
+			require.Equal(t, expBottom, just.SliceBottomK(in, k, less))
+		}
+	})
+}
+
+func TestSliceNthElement(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		in := make([]int, 1+rnd.Intn(100))
+		for j := range in {
+			in[j] = rnd.Intn(1 + i)
+		}
+		n := rnd.Intn(len(in))
+		sorted := just.SliceSortCopy(in, less)
+
+		just.SliceNthElement(in, n, less)
+		require.Equal(t, sorted[n], in[n])
+		for j := range in {
+			require.False(t, j < n && in[j] > in[n], "%v at %d", in, n)
+			require.False(t, j > n && in[j] < in[n], "%v at %d", in, n)
+		}
+		require.True(t, just.SliceEqualUnordered(sorted, in))
+	}
+
+	t.Run("sorted_input", func(t *testing.T) {
+		in := just.SliceRange(0, 1000, 1)
+		just.SliceNthElement(in, 500, less)
+		assert.Equal(t, 500, in[500])
+
+		just.SliceNthElement(in, 0, just.LessReverse(less))
+		assert.Equal(t, 999, in[0])
+	})
+
+	t.Run("invalid_n", func(t *testing.T) {
+		assert.Panics(t, func() { just.SliceNthElement([]int{1}, 1, less) })
+		assert.Panics(t, func() { just.SliceNthElement([]int{1}, -1, less) })
+		assert.Panics(t, func() { just.SliceNthElement([]int{}, 0, less) })
+	})
+}
+
+func TestSlicePartialSort(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		in := make([]int, rnd.Intn(50))
+		for j := range in {
+			in[j] = rnd.Intn(30)
+		}
+		k := rnd.Intn(60) - 5
+		sorted := just.SliceSortCopy(in, less)
+
+		just.SlicePartialSort(in, k, less)
+		require.Equal(t, just.SliceGetFirstN(sorted, just.Max(k, 0)), just.SliceGetFirstN(in, just.Max(k, 0)))
+		require.True(t, just.SliceEqualUnordered(sorted, in))
+	}
+}
+
+func TestSliceGroupByOrdered(t *testing.T) {
+	t.Parallel()
+
+	parity := func(v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+
+		return "odd"
+	}
+
+	assert.Equal(t, []just.KV[string, []int]{}, just.SliceGroupByOrdered([]int(nil), parity))
+	assert.Equal(t, []just.KV[string, []int]{
+		{Key: "even", Val: []int{2, 4}},
+		{Key: "odd", Val: []int{1, 3, 5}},
+	}, just.SliceGroupByOrdered([]int{2, 1, 3, 4, 5}, parity))
+}
+
+func TestSliceGroupByAggregations(t *testing.T) {
+	t.Parallel()
+
+	type sale struct {
+		Region string
+		Amount int
+	}
+
+	in := []sale{
+		{"eu", 10},
+		{"us", 5},
+		{"eu", 30},
+		{"us", 5},
+		{"eu", 20},
+		{"asia", 1},
+	}
+	region := func(s sale) string { return s.Region }
+	byAmount := just.LessBy(func(s sale) int { return s.Amount })
+
+	assert.Equal(t, map[string]int{"eu": 3, "us": 2, "asia": 1}, just.SliceGroupByCount(in, region))
+	assert.Equal(t, map[string]float64{"eu": 60, "us": 10, "asia": 1},
+		just.SliceGroupBySum(in, region, func(s sale) float64 { return float64(s.Amount) }))
+	assert.Equal(t, map[string]sale{"eu": {"eu", 10}, "us": {"us", 5}, "asia": {"asia", 1}},
+		just.SliceGroupByMin(in, region, byAmount))
+	assert.Equal(t, map[string]sale{"eu": {"eu", 30}, "us": {"us", 5}, "asia": {"asia", 1}},
+		just.SliceGroupByMax(in, region, byAmount))
+	assert.Equal(t, map[string]sale{"eu": {"eu", 10}, "us": {"us", 5}, "asia": {"asia", 1}},
+		just.SliceGroupByFirst(in, region))
+	assert.Equal(t, map[string]sale{"eu": {"eu", 20}, "us": {"us", 5}, "asia": {"asia", 1}},
+		just.SliceGroupByLast(in, region))
+	assert.Equal(t, map[string][]int{"eu": {10, 30, 20}, "us": {5, 5}, "asia": {1}},
+		just.SliceGroupByFold(in, region, []int(nil), func(acc []int, s sale) []int {
+			return append(acc, s.Amount)
+		}))
+
+	assert.Equal(t, map[string]int{}, just.SliceGroupByCount([]sale(nil), region))
+
+	t.Run("min_max_first_of_equal", func(t *testing.T) {
+		in := []sale{{"a", 1}, {"b", 1}, {"c", 2}, {"d", 2}}
+		all := func(sale) int { return 0 }
+
+		assert.Equal(t, map[int]sale{0: {"a", 1}}, just.SliceGroupByMin(in, all, byAmount))
+		assert.Equal(t, map[int]sale{0: {"c", 2}}, just.SliceGroupByMax(in, all, byAmount))
+	})
+}
+
+func TestSlicePartition(t *testing.T) {
+	t.Parallel()
+
+	odd := func(v int) bool { return v%2 != 0 }
+
+	matched, unmatched := just.SlicePartition([]int{1, 2, 3, 4, 5}, odd)
+	assert.Equal(t, []int{1, 3, 5}, matched)
+	assert.Equal(t, []int{2, 4}, unmatched)
+
+	matched, unmatched = just.SlicePartition([]int(nil), odd)
+	assert.Equal(t, []int{}, matched)
+	assert.Equal(t, []int{}, unmatched)
+}
+
+func TestSliceIndexBy(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		ID   int
+		Name string
+	}
+	id := func(u user) int { return u.ID }
+
+	res, err := just.SliceIndexBy([]user{{1, "a"}, {2, "b"}}, id)
+	require.NoError(t, err)
+	assert.Equal(t, map[int]user{1: {1, "a"}, 2: {2, "b"}}, res)
+
+	res, err = just.SliceIndexBy([]user(nil), id)
+	require.NoError(t, err)
+	assert.Equal(t, map[int]user{}, res)
+
+	_, err = just.SliceIndexBy([]user{{1, "a"}, {2, "b"}, {1, "c"}}, id)
+	assert.ErrorIs(t, err, just.ErrDuplicateKey)
+	assert.EqualError(t, err, "duplicate key: 1 at 0 and 2")
+}
+
+func TestSliceReduce(t *testing.T) {
+	t.Parallel()
+
+	sum := func(acc, v int) int { return acc + v }
+
+	assert.Equal(t, just.Null(6), just.SliceReduce([]int{1, 2, 3}, sum))
+	assert.Equal(t, just.Null(1), just.SliceReduce([]int{1}, sum))
+	assert.Equal(t, just.NullNull[int](), just.SliceReduce([]int(nil), sum))
+	assert.Equal(t, just.Null("abc"), just.SliceReduce([]string{"a", "b", "c"}, func(acc, v string) string {
+		return acc + v
+	}))
+}
+
+func TestSliceFold(t *testing.T) {
+	t.Parallel()
+
+	totalLen := func(acc int, v string) int { return acc + len(v) }
+
+	assert.Equal(t, 5, just.SliceFold([]string{"a", "bb", "cc"}, 0, totalLen))
+	assert.Equal(t, 10, just.SliceFold([]string(nil), 10, totalLen))
+	assert.Equal(t, []int{3, 2, 1}, just.SliceFold([]int{1, 2, 3}, []int{}, func(acc []int, v int) []int {
+		return append([]int{v}, acc...)
+	}))
+}
@@ -0,0 +1,55 @@
+package just
+
+// Pair represents two values of different types.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Values returns both values of the pair.
+func (p Pair[A, B]) Values() (A, B) {
+	return p.First, p.Second
+}
+
+// NewPair returns a pair of `a` and `b`.
+func NewPair[A, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{
+		First:  a,
+		Second: b,
+	}
+}
+
+// Triple represents three values of different types.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Values returns all values of the triple.
+func (t Triple[A, B, C]) Values() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// NewTriple returns a triple of `a`, `b` and `c`.
+func NewTriple[A, B, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{
+		First:  a,
+		Second: b,
+		Third:  c,
+	}
+}
+
+// Pair returns the pair where First is the key and Second is the value.
+func (kv KV[K, V]) Pair() Pair[K, V] {
+	return NewPair(kv.Key, kv.Val)
+}
+
+// KVFromPair returns KV where the key is Pair.First and the value is
+// Pair.Second.
+func KVFromPair[K comparable, V any](p Pair[K, V]) KV[K, V] {
+	return KV[K, V]{
+		Key: p.First,
+		Val: p.Second,
+	}
+}
@@ -0,0 +1,42 @@
+package just_test
+
+import (
+	"testing"
+
+	"github.com/kazhuravlev/just"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPair(t *testing.T) {
+	t.Parallel()
+
+	p := just.NewPair(1, "one")
+	assert.Equal(t, just.Pair[int, string]{First: 1, Second: "one"}, p)
+
+	a, b := p.Values()
+	assert.Equal(t, 1, a)
+	assert.Equal(t, "one", b)
+}
+
+func TestTriple(t *testing.T) {
+	t.Parallel()
+
+	tr := just.NewTriple(1, "one", true)
+	assert.Equal(t, just.Triple[int, string, bool]{First: 1, Second: "one", Third: true}, tr)
+
+	a, b, c := tr.Values()
+	assert.Equal(t, 1, a)
+	assert.Equal(t, "one", b)
+	assert.True(t, c)
+}
+
+func TestKVPair(t *testing.T) {
+	t.Parallel()
+
+	kv := just.KV[string, int]{Key: "a", Val: 1}
+	assert.Equal(t, just.NewPair("a", 1), kv.Pair())
+	assert.Equal(t, kv, just.KVFromPair(kv.Pair()))
+
+	pairs := just.SliceMap(just.MapPairs(map[string]int{"a": 1}), just.KV[string, int].Pair)
+	assert.Equal(t, []just.Pair[string, int]{{First: "a", Second: 1}}, pairs)
+}
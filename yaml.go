@@ -0,0 +1,28 @@
+package just
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/goccy/go-yaml"
+)
+
+// YamlParseType parse byte slice to specific type.
+func YamlParseType[T any](bb []byte) (*T, error) {
+	var target T
+	if err := yaml.Unmarshal(bb, &target); err != nil {
+		return nil, fmt.Errorf("unmarshal type: %w", err)
+	}
+
+	return &target, nil
+}
+
+// YamlParseTypeF parse yaml file into specific T.
+func YamlParseTypeF[T any](filename string) (*T, error) {
+	bb, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	return YamlParseType[T](bb)
+}
@@ -0,0 +1,41 @@
+package just_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kazhuravlev/just"
+	"github.com/stretchr/testify/require"
+)
+
+func TestYamlParseType(t *testing.T) {
+	t.Run("valid_type", func(t *testing.T) {
+		res, err := just.YamlParseType[SomeType]([]byte(`id: 42`))
+		require.NoError(t, err)
+		require.Equal(t, SomeType{ID: 42}, *res)
+	})
+
+	t.Run("invalid_type", func(t *testing.T) {
+		res, err := just.YamlParseType[SomeType]([]byte(`id: [1, 2]`))
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}
+
+func TestYamlParseTypeF(t *testing.T) {
+	t.Run("valid_type", func(t *testing.T) {
+		filename := filepath.Join(t.TempDir(), "file.yaml")
+		require.NoError(t, os.WriteFile(filename, []byte("id: 42\n"), 0o600))
+
+		res, err := just.YamlParseTypeF[SomeType](filename)
+		require.NoError(t, err)
+		require.Equal(t, SomeType{ID: 42}, *res)
+	})
+
+	t.Run("invalid_type", func(t *testing.T) {
+		res, err := just.YamlParseTypeF[SomeType]("/path/not-exists/png")
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+}